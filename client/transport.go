@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type RequestValidator interface {
+	Validate(req *http.Request) error
+}
+
+type ResponseValidator interface {
+	Validate(resp *http.Response) error
+}
+
+// Transport validates the outgoing request before it is sent with the base
+// round tripper, the response is validated when a response validator is present.
+type Transport struct {
+	Base     http.RoundTripper
+	Request  RequestValidator
+	Response ResponseValidator
+}
+
+func (t Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	send, err := t.validateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base().RoundTrip(send)
+	if err != nil {
+		return nil, err
+	}
+	if t.Response == nil {
+		return resp, nil
+	}
+	if err := t.Response.Validate(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// validateRequest returns a copy of the request with the body restored after
+// the validation has read it.
+func (t Transport) validateRequest(req *http.Request) (*http.Request, error) {
+	if t.Request == nil {
+		return req, nil
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		if err := t.Request.Validate(req.Clone(req.Context())); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("request body read: %w", err)
+	}
+	getBody := func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+
+	validate := req.Clone(req.Context())
+	validate.Body, _ = getBody()
+	if err := t.Request.Validate(validate); err != nil {
+		return nil, err
+	}
+
+	send := req.Clone(req.Context())
+	send.Body, _ = getBody()
+	send.GetBody = getBody
+	return send, nil
+}
+
+func (t Transport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request"
+	"github.com/g8rswimmer/httpx/request/rerror"
+	"github.com/g8rswimmer/httpx/response"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		b, _ := io.ReadAll(req.Body)
+		received = string(b)
+		if req.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	schema, err := request.SchemaFromJSON(strings.NewReader(`{
+		"endpoint": {
+			"method": "POST",
+			"endpoint": "/users"
+		},
+		"body": {
+			"body": {
+				"object": {
+					"required_fields": {
+						"one_of": [["name"]]
+					},
+					"parameters": {
+						"name": {
+							"validation": {
+								"string_validator": {}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+
+	type fields struct {
+		Request  RequestValidator
+		Response ResponseValidator
+	}
+	type args struct {
+		path string
+		body string
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		args         args
+		wantErr      bool
+		wantReceived string
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Request: schema,
+				Response: response.Schema{
+					StatusCodes: []int{http.StatusCreated},
+				},
+			},
+			args: args{
+				path: "/users",
+				body: `{"name": "Gary"}`,
+			},
+			wantErr:      false,
+			wantReceived: `{"name": "Gary"}`,
+		},
+		{
+			name:   "success: no validators",
+			fields: fields{},
+			args: args{
+				path: "/fail",
+				body: `{"age": 10}`,
+			},
+			wantErr:      false,
+			wantReceived: `{"age": 10}`,
+		},
+		{
+			name: "fail: request",
+			fields: fields{
+				Request: schema,
+			},
+			args: args{
+				path: "/users",
+				body: `{"age": 10}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: response",
+			fields: fields{
+				Response: response.Schema{
+					StatusCodes: []int{http.StatusCreated},
+				},
+			},
+			args: args{
+				path: "/fail",
+				body: `{"name": "Gary"}`,
+			},
+			wantErr:      true,
+			wantReceived: `{"name": "Gary"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = ""
+			client := &http.Client{
+				Transport: Transport{
+					Request:  tt.fields.Request,
+					Response: tt.fields.Response,
+				},
+			}
+			resp, err := client.Post(server.URL+tt.args.path, "application/json", strings.NewReader(tt.args.body))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Transport.RoundTrip() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if received != tt.wantReceived {
+				t.Errorf("Transport.RoundTrip() received = %s, want %s", received, tt.wantReceived)
+			}
+			if tt.wantErr {
+				var schemaErr *rerror.SchemaErr
+				if !errors.As(err, &schemaErr) {
+					t.Errorf("Transport.RoundTrip() error = %v, want *rerror.SchemaErr", err)
+				}
+				return
+			}
+			resp.Body.Close()
+		})
+	}
+}
@@ -0,0 +1,491 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/query"
+)
+
+const (
+	kindJBody    = "jbody"
+	kindQuery    = "query"
+	kindEndpoint = "endpoint"
+)
+
+var initialisms = map[string]string{
+	"api":  "API",
+	"html": "HTML",
+	"http": "HTTP",
+	"id":   "ID",
+	"ids":  "IDs",
+	"ip":   "IP",
+	"json": "JSON",
+	"sql":  "SQL",
+	"uri":  "URI",
+	"url":  "URL",
+	"uuid": "UUID",
+	"xml":  "XML",
+}
+
+type generator struct {
+	pkg     string
+	imports map[string]bool
+	decls   []string
+}
+
+// generate returns the Go source of the type, and its validate method, for
+// the schema JSON.
+func generate(kind, typeName, pkg string, raw []byte) ([]byte, error) {
+	if !token.IsIdentifier(typeName) || !token.IsExported(typeName) {
+		return nil, fmt.Errorf("type name [%s] must be an exported identifier", typeName)
+	}
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("package name [%s] is not an identifier", pkg)
+	}
+	g := &generator{
+		pkg: pkg,
+		imports: map[string]bool{
+			"strings": true,
+		},
+	}
+	var err error
+	switch kind {
+	case kindJBody:
+		err = g.jbody(typeName, raw)
+	case kindQuery:
+		err = g.query(typeName, raw)
+	case kindEndpoint:
+		err = g.endpoint(typeName, raw)
+	default:
+		err = fmt.Errorf("schema kind [%s] must be %s, %s or %s", kind, kindJBody, kindQuery, kindEndpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := g.schema(typeName, kind, raw); err != nil {
+		return nil, err
+	}
+	return g.source()
+}
+
+func (g *generator) source() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, "// Code generated by httpx-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", g.pkg)
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	fmt.Fprint(buf, "import (\n")
+	for _, imp := range imports {
+		if !strings.Contains(imp, ".") {
+			fmt.Fprintf(buf, "%q\n", imp)
+		}
+	}
+	fmt.Fprint(buf, "\n")
+	for _, imp := range imports {
+		if strings.Contains(imp, ".") {
+			fmt.Fprintf(buf, "%q\n", imp)
+		}
+	}
+	fmt.Fprint(buf, ")\n")
+	for _, decl := range g.decls {
+		fmt.Fprintf(buf, "\n%s", decl)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated source format: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) schema(typeName, kind string, raw []byte) error {
+	if !json.Valid(raw) {
+		return errors.New("schema is not valid json")
+	}
+	schemaJSON := string(bytes.TrimSpace(raw))
+	literal := "`" + schemaJSON + "`"
+	if strings.Contains(schemaJSON, "`") || strings.Contains(schemaJSON, "\r") {
+		literal = strconv.Quote(schemaJSON)
+	}
+	g.imports["github.com/g8rswimmer/httpx/request/"+kind] = true
+	name := schemaName(typeName)
+	g.decls = append(g.decls, fmt.Sprintf(`const %sJSON = %s
+
+var %s = func() %s.Schema {
+	schema, err := %s.SchemaFromJSON(strings.NewReader(%sJSON))
+	if err != nil {
+		panic(err)
+	}
+	return schema
+}()
+`, name, literal, name, kind, kind, name))
+	return nil
+}
+
+func schemaName(typeName string) string {
+	r := []rune(typeName)
+	i := 0
+	for i < len(r) && unicode.IsUpper(r[i]) {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+		i++
+	}
+	return string(r) + "Schema"
+}
+
+type structField struct {
+	name   string
+	goType string
+	tag    string
+	doc    string
+}
+
+func declaration(doc string, typeName string, fields []structField) string {
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, doc)
+	fmt.Fprintf(buf, "type %s struct {\n", typeName)
+	for _, f := range fields {
+		if len(f.doc) > 0 {
+			fmt.Fprintf(buf, "// %s\n", f.doc)
+		}
+		fmt.Fprintf(buf, "%s %s `%s`\n", f.name, f.goType, f.tag)
+	}
+	fmt.Fprint(buf, "}\n")
+	return buf.String()
+}
+
+func typeDoc(typeName, title, description, kind string) string {
+	doc := fmt.Sprintf("// %s is generated from the %s schema.\n", typeName, kind)
+	if len(title) > 0 {
+		doc = fmt.Sprintf("// %s is generated from the %s %s schema.\n", typeName, title, kind)
+	}
+	if len(description) > 0 {
+		doc += "//\n// " + strings.ReplaceAll(description, "\n", "\n// ") + "\n"
+	}
+	return doc
+}
+
+func (g *generator) jbody(typeName string, raw []byte) error {
+	schema, err := jbody.SchemaFromJSON(bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	doc := typeDoc(typeName, schema.Title, schema.Description, "json body")
+	switch {
+	case schema.Body.Object != nil && schema.Body.ObjectArray != nil:
+		return errors.New("body can not be an object AND object array")
+	case schema.Body.Object != nil:
+		if err := g.object(doc, typeName, *schema.Body.Object); err != nil {
+			return err
+		}
+	case schema.Body.ObjectArray != nil:
+		item := typeName + "Item"
+		g.decls = append(g.decls, fmt.Sprintf("%stype %s []%s\n", doc, typeName, item))
+		itemDoc := fmt.Sprintf("// %s is an object of %s.\n", item, typeName)
+		if err := g.object(itemDoc, item, schema.Body.ObjectArray.Object); err != nil {
+			return err
+		}
+	default:
+		return errors.New("body must be an object or object array")
+	}
+
+	for _, imp := range []string{"bytes", "encoding/json", "net/http"} {
+		g.imports[imp] = true
+	}
+	g.decls = append(g.decls, fmt.Sprintf(`// Validate validates the value with the json body schema.
+func (v %s) Validate() error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, "/", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	return %s.Validate(req)
+}
+`, typeName, schemaName(typeName)))
+	return nil
+}
+
+func (g *generator) object(doc, typeName string, obj jbody.ObjectValidator) error {
+	// the declaration is reserved so it is before the nested types
+	idx := len(g.decls)
+	g.decls = append(g.decls, "")
+
+	required := requiredSet(obj.RequiredFields.OneOf)
+	fields := []structField{}
+	names := map[string]string{}
+	for _, param := range sortedKeys(obj.Parameters) {
+		name, err := goName(param)
+		if err != nil {
+			return fmt.Errorf("type [%s]: %w", typeName, err)
+		}
+		if other, has := names[name]; has {
+			return fmt.Errorf("type [%s]: parameters [%s] and [%s] have the same field name", typeName, other, param)
+		}
+		names[name] = param
+
+		goType, slice, err := g.jbodyType(typeName, name, param, obj.Parameters[param].Validation)
+		if err != nil {
+			return fmt.Errorf("type [%s] parameter [%s]: %w", typeName, param, err)
+		}
+		tag := fmt.Sprintf(`json:"%s"`, param)
+		if !required[param] {
+			tag = fmt.Sprintf(`json:"%s,omitempty"`, param)
+			if !slice {
+				goType = "*" + goType
+			}
+		}
+		fields = append(fields, structField{
+			name:   name,
+			goType: goType,
+			tag:    tag,
+		})
+	}
+
+	g.decls[idx] = declaration(doc, typeName, fields)
+	return nil
+}
+
+func (g *generator) jbodyType(parent, name, param string, v jbody.ParameterValidation) (string, bool, error) {
+	count := 0
+	for _, present := range []bool{v.Object != nil, v.ObjectArray != nil, v.String != nil, v.StringArray != nil, v.Number != nil, v.NumberArray != nil, v.Time != nil, v.TimeArray != nil, v.Boolean != nil} {
+		if present {
+			count++
+		}
+	}
+	if count != 1 {
+		return "", false, errors.New("parameter must have one validator")
+	}
+	nested := parent + name
+	switch {
+	case v.String != nil, v.Time != nil:
+		return "string", false, nil
+	case v.Number != nil:
+		return "float64", false, nil
+	case v.Boolean != nil:
+		return "bool", false, nil
+	case v.StringArray != nil, v.TimeArray != nil:
+		return "[]string", true, nil
+	case v.NumberArray != nil:
+		return "[]float64", true, nil
+	case v.Object != nil:
+		doc := fmt.Sprintf("// %s is the %s parameter of %s.\n", nested, param, parent)
+		if err := g.object(doc, nested, *v.Object); err != nil {
+			return "", false, err
+		}
+		return nested, false, nil
+	default:
+		doc := fmt.Sprintf("// %s is an object of the %s parameter of %s.\n", nested, param, parent)
+		if err := g.object(doc, nested, v.ObjectArray.Object); err != nil {
+			return "", false, err
+		}
+		return "[]" + nested, true, nil
+	}
+}
+
+func (g *generator) query(typeName string, raw []byte) error {
+	schema, err := query.SchemaFromJSON(bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	required := requiredSet(schema.RequiredFields.OneOf)
+	fields := []structField{}
+	names := map[string]string{}
+	body := &bytes.Buffer{}
+	for _, param := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[param]
+		name, err := goName(param)
+		if err != nil {
+			return fmt.Errorf("type [%s]: %w", typeName, err)
+		}
+		if other, has := names[name]; has {
+			return fmt.Errorf("type [%s]: parameters [%s] and [%s] have the same field name", typeName, other, param)
+		}
+		names[name] = param
+
+		v := properties.Validation
+		field := "v." + name
+		switch {
+		case v.String != nil, v.Time != nil, v.Number != nil, v.Boolean != nil:
+			goType, expr := "string", "%s"
+			switch {
+			case v.Number != nil:
+				goType, expr = "float64", "strconv.FormatFloat(%s, 'f', -1, 64)"
+				g.imports["strconv"] = true
+			case v.Boolean != nil:
+				goType, expr = "bool", "strconv.FormatBool(%s)"
+				g.imports["strconv"] = true
+			}
+			if required[param] {
+				fmt.Fprintf(body, "values.Set(%q, %s)\n", param, fmt.Sprintf(expr, field))
+			} else {
+				goType = "*" + goType
+				fmt.Fprintf(body, "if %s != nil {\nvalues.Set(%q, %s)\n}\n", field, param, fmt.Sprintf(expr, "*"+field))
+			}
+			fields = append(fields, structField{
+				name:   name,
+				goType: goType,
+				tag:    fmt.Sprintf(`query:"%s"`, param),
+				doc:    properties.Description,
+			})
+		default:
+			goType, elems := "[]string", field
+			if v.NumberArray != nil {
+				goType, elems = "[]float64", "s"
+				g.imports["strconv"] = true
+			}
+			fmt.Fprintf(body, "if len(%s) > 0 {\n", field)
+			if v.NumberArray != nil {
+				fmt.Fprintf(body, "s := make([]string, len(%s))\nfor i := range %s {\ns[i] = strconv.FormatFloat(%s[i], 'f', -1, 64)\n}\n", field, field, field)
+			}
+			if properties.InlineArray {
+				fmt.Fprintf(body, "values.Set(%q, strings.Join(%s, %q))\n", param, elems, properties.InlineArraySeperator)
+			} else {
+				fmt.Fprintf(body, "values[%q] = %s\n", param, elems)
+			}
+			fmt.Fprint(body, "}\n")
+			fields = append(fields, structField{
+				name:   name,
+				goType: goType,
+				tag:    fmt.Sprintf(`query:"%s"`, param),
+				doc:    properties.Description,
+			})
+		}
+	}
+	g.decls = append(g.decls, declaration(typeDoc(typeName, schema.Title, schema.Description, "query"), typeName, fields))
+
+	g.imports["net/http"] = true
+	g.imports["net/url"] = true
+	g.decls = append(g.decls, fmt.Sprintf(`// Validate validates the value with the query schema.
+func (v %s) Validate() error {
+	values := url.Values{}
+	%s
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{RawQuery: values.Encode()},
+	}
+	return %s.Validate(req)
+}
+`, typeName, strings.TrimSpace(body.String()), schemaName(typeName)))
+	return nil
+}
+
+func (g *generator) endpoint(typeName string, raw []byte) error {
+	schema, err := endpoint.SchemaFromJSON(bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	fields := []structField{}
+	names := map[string]string{}
+	exprs := map[string]string{}
+	for _, key := range sortedKeys(schema.PathVariables) {
+		param := strings.TrimSuffix(strings.TrimPrefix(key, "{"), "}")
+		name, err := goName(param)
+		if err != nil {
+			return fmt.Errorf("type [%s]: %w", typeName, err)
+		}
+		if other, has := names[name]; has {
+			return fmt.Errorf("type [%s]: path variables [%s] and [%s] have the same field name", typeName, other, key)
+		}
+		names[name] = key
+
+		goType, expr := "string", "v."+name
+		if schema.PathVariables[key].Validation.Number != nil {
+			goType, expr = "float64", fmt.Sprintf("strconv.FormatFloat(v.%s, 'f', -1, 64)", name)
+			g.imports["strconv"] = true
+		}
+		exprs[key] = expr
+		fields = append(fields, structField{
+			name:   name,
+			goType: goType,
+			tag:    fmt.Sprintf(`path:"%s"`, param),
+		})
+	}
+	g.decls = append(g.decls, declaration(typeDoc(typeName, schema.Title, schema.Description, "endpoint"), typeName, fields))
+
+	segments := []string{}
+	for _, segment := range strings.Split(schema.Endpoint, "/") {
+		if expr, has := exprs[segment]; has {
+			segments = append(segments, expr)
+			continue
+		}
+		segments = append(segments, strconv.Quote(segment))
+	}
+	g.imports["net/http"] = true
+	g.imports["net/url"] = true
+	g.decls = append(g.decls, fmt.Sprintf(`// Validate validates the value with the endpoint schema.
+func (v %s) Validate() error {
+	segments := []string{%s}
+	req := &http.Request{
+		Method: %q,
+		URL:    &url.URL{Path: strings.Join(segments, "/")},
+	}
+	return %s.Validate(req)
+}
+`, typeName, strings.Join(segments, ", "), schema.Method, schemaName(typeName)))
+	return nil
+}
+
+// requiredSet returns the fields that are in every one of combination.
+func requiredSet(oneOf [][]string) map[string]bool {
+	required := map[string]bool{}
+	for i, combination := range oneOf {
+		set := map[string]bool{}
+		for _, field := range combination {
+			if i == 0 || required[field] {
+				set[field] = true
+			}
+		}
+		required = set
+	}
+	return required
+}
+
+func goName(param string) (string, error) {
+	words := strings.FieldsFunc(param, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	name := ""
+	for _, word := range words {
+		if initialism, has := initialisms[strings.ToLower(word)]; has {
+			name += initialism
+			continue
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		name += string(r)
+	}
+	switch {
+	case len(name) == 0:
+		return "", fmt.Errorf("parameter [%s] can not be a field name", param)
+	case unicode.IsDigit([]rune(name)[0]):
+		name = "Field" + name
+	}
+	return name, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
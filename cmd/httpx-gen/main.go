@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/g8rswimmer/httpx/internal/schemaref"
+)
+
+const usage = `usage: httpx-gen -kind <jbody|query|endpoint> -type <name> [flags] <schema.json>
+
+Generates a Go type, and a Validate method, from a schema file.
+
+	//go:generate go run github.com/g8rswimmer/httpx/cmd/httpx-gen -kind jbody -type User -out user_gen.go user_schema.json
+
+flags:
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("httpx-gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	kind := fs.String("kind", "", "schema kind, jbody, query or endpoint")
+	typeName := fs.String("type", "", "name of the generated type")
+	pkg := fs.String("package", os.Getenv("GOPACKAGE"), "package of the generated file, $GOPACKAGE when not present")
+	out := fs.String("out", "", "file the source is written to, stdout when not present")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 || len(*kind) == 0 || len(*typeName) == 0 || len(*pkg) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	raw, err := schemaref.ResolveFile(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "read schema: %v\n", err)
+		return 1
+	}
+	src, err := generate(*kind, *typeName, *pkg, raw)
+	if err != nil {
+		fmt.Fprintf(stderr, "generate [%s]: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	if len(*out) == 0 {
+		stdout.Write(src)
+		return 0
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintf(stderr, "write source: %v\n", err)
+		return 1
+	}
+	return 0
+}
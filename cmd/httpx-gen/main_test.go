@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testBodySchemaJSON = `{
+		"title": "create user",
+		"body": {
+			"object": {
+				"required_fields": {"one_of": [["name", "address"], ["name", "user_id"]]},
+				"parameters": {
+					"name": {"validation": {"string_validator": {}}},
+					"user_id": {"validation": {"number_validator": {"min": 1}}},
+					"tags": {"validation": {"string_array_validator": {}}},
+					"address": {
+						"validation": {
+							"object_validator": {
+								"required_fields": {"one_of": [["zip"]]},
+								"parameters": {
+									"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}}
+								}
+							}
+						}
+					},
+					"pets": {
+						"validation": {
+							"object_array_validator": {
+								"object": {
+									"parameters": {
+										"name": {"validation": {"string_validator": {}}}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+	testQuerySchemaJSON = `{
+		"title": "list users",
+		"required_fields": {"one_of": [["limit"]]},
+		"parameters": {
+			"limit": {"description": "page size", "validation": {"number_validator": {"min": 1}}},
+			"active": {"validation": {"boolean_validator": {}}},
+			"ids": {"inline_array": true, "inline_array_seperator": ",", "validation": {"number_array_validator": {}}}
+		}
+	}`
+	testEndpointSchemaJSON = `{
+		"title": "get user",
+		"method": "GET",
+		"endpoint": "/users/{user_id}",
+		"path_variables": {
+			"{user_id}": {"validation": {"number_validator": {"min": 1}}}
+		}
+	}`
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	schemas := map[string]string{
+		"body.json":     testBodySchemaJSON,
+		"query.json":    testQuerySchemaJSON,
+		"endpoint.json": testEndpointSchemaJSON,
+		"users/user.json": `{
+			"body": {
+				"object": {
+					"parameters": {
+						"name": {"validation": {"string_validator": {}}},
+						"address": {"validation": {"object_validator": {"$ref": "../common.json#/definitions/address"}}}
+					}
+				}
+			}
+		}`,
+		"common.json": `{
+			"definitions": {
+				"address": {"parameters": {"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}}}}
+			}
+		}`,
+	}
+	for name, schema := range schemas {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil {
+			t.Fatalf("mkdir schema error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(schema), 0o644); err != nil {
+			t.Fatalf("write schema error = %v", err)
+		}
+	}
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		want     []string
+	}{
+		{
+			name: "success: jbody",
+			args: args{
+				args: []string{"-kind", "jbody", "-type", "User", "-package", "models", filepath.Join(dir, "body.json")},
+			},
+			wantCode: 0,
+			want: []string{
+				"Address *UserAddress `json:\"address,omitempty\"`",
+				"Name    string       `json:\"name\"`",
+				"Pets    []UserPets   `json:\"pets,omitempty\"`",
+				"Zip string `json:\"zip\"`",
+				"func (v User) Validate() error",
+			},
+		},
+		{
+			name: "success: query",
+			args: args{
+				args: []string{"-kind", "query", "-type", "ListUsers", "-package", "models", filepath.Join(dir, "query.json")},
+			},
+			wantCode: 0,
+			want: []string{
+				"Active *bool     `query:\"active\"`",
+				"IDs    []float64 `query:\"ids\"`",
+				"// page size",
+				`values.Set("ids", strings.Join(s, ","))`,
+			},
+		},
+		{
+			name: "success: endpoint",
+			args: args{
+				args: []string{"-kind", "endpoint", "-type", "GetUser", "-package", "models", filepath.Join(dir, "endpoint.json")},
+			},
+			wantCode: 0,
+			want: []string{
+				"UserID float64 `path:\"user_id\"`",
+				`segments := []string{"", "users", strconv.FormatFloat(v.UserID, 'f', -1, 64)}`,
+			},
+		},
+		{
+			name: "success: references",
+			args: args{
+				args: []string{"-kind", "jbody", "-type", "User", "-package", "models", filepath.Join(dir, "users", "user.json")},
+			},
+			wantCode: 0,
+			want: []string{
+				"Address *UserAddress `json:\"address,omitempty\"`",
+				"Zip *string `json:\"zip,omitempty\"`",
+			},
+		},
+		{
+			name: "fail: kind",
+			args: args{
+				args: []string{"-kind", "xml", "-type", "User", "-package", "models", filepath.Join(dir, "body.json")},
+			},
+			wantCode: 1,
+		},
+		{
+			name: "fail: type name",
+			args: args{
+				args: []string{"-kind", "jbody", "-type", "user", "-package", "models", filepath.Join(dir, "body.json")},
+			},
+			wantCode: 1,
+		},
+		{
+			name: "fail: schema",
+			args: args{
+				args: []string{"-kind", "query", "-type", "User", "-package", "models", filepath.Join(dir, "body.json")},
+			},
+			wantCode: 1,
+		},
+		{
+			name: "fail: usage",
+			args: args{
+				args: []string{"-kind", "jbody", filepath.Join(dir, "body.json")},
+			},
+			wantCode: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			if code := run(tt.args.args, stdout, stderr); code != tt.wantCode {
+				t.Fatalf("run() = %d, want %d: %s", code, tt.wantCode, stderr.String())
+			}
+			if tt.wantCode != 0 {
+				return
+			}
+			src := stdout.String()
+			for _, want := range tt.want {
+				if !strings.Contains(src, want) {
+					t.Errorf("run() source does not contain [%s]\n%s", want, src)
+				}
+			}
+			f, err := parser.ParseFile(fset, tt.name+".go", src, 0)
+			if err != nil {
+				t.Fatalf("parser.ParseFile() error = %v", err)
+			}
+			conf := types.Config{Importer: imp}
+			if _, err := conf.Check("models", fset, []*ast.File{f}, nil); err != nil {
+				t.Errorf("types.Config.Check() error = %v\n%s", err, src)
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		param   string
+		want    string
+		wantErr bool
+	}{
+		{param: "user_id", want: "UserID"},
+		{param: "street_1", want: "Street1"},
+		{param: "firstName", want: "FirstName"},
+		{param: "X-Request-ID", want: "XRequestID"},
+		{param: "1st", want: "Field1st"},
+		{param: "--", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.param, func(t *testing.T) {
+			got, err := goName(tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("goName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("goName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/g8rswimmer/httpx/request"
+	"github.com/g8rswimmer/httpx/request/openapi"
+)
+
+const usage = `usage: httpx-openapi <command> [flags] <file>
+
+commands:
+  import    convert an OpenAPI 3 JSON document into request schema files
+  export    convert request schema files into an OpenAPI 3.1 JSON document
+`
+
+var fileNameRegEx = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "import":
+		return runImport(args[1:], stdout, stderr)
+	case "export":
+		return runExport(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "unknown command [%s]\n%s", args[0], usage)
+		return 2
+	}
+}
+
+func runImport(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	out := fs.String("out", ".", "directory the request schema files are written to")
+	strict := fs.Bool("strict", false, "exit with a failure when unsupported constructs are reported")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprint(stderr, "import requires an OpenAPI document\n")
+		return 2
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "open document: %v\n", err)
+		return 1
+	}
+	defer f.Close()
+
+	operations, unsupported, err := openapi.ImportJSON(f)
+	if err != nil {
+		fmt.Fprintf(stderr, "import document: %v\n", err)
+		return 1
+	}
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		fmt.Fprintf(stderr, "output directory: %v\n", err)
+		return 1
+	}
+	for _, op := range operations {
+		enc, err := json.MarshalIndent(op.Schema, "", "    ")
+		if err != nil {
+			fmt.Fprintf(stderr, "operation [%s] encode: %v\n", op.OperationID, err)
+			return 1
+		}
+		name := filepath.Join(*out, fileNameRegEx.ReplaceAllString(op.OperationID, "_")+".json")
+		if err := os.WriteFile(name, enc, 0o644); err != nil {
+			fmt.Fprintf(stderr, "operation [%s] write: %v\n", op.OperationID, err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "%s %s -> %s\n", op.Method, op.Path, name)
+	}
+	for _, u := range unsupported {
+		fmt.Fprintf(stderr, "unsupported: %s: %s\n", u.Location, u.Msg)
+	}
+	if *strict && len(unsupported) > 0 {
+		return 1
+	}
+	return 0
+}
+
+func runExport(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	title := fs.String("title", "", "document info title")
+	version := fs.String("version", "", "document info version")
+	description := fs.String("description", "", "document info description")
+	out := fs.String("out", "", "file the document is written to, stdout when not present")
+	strict := fs.Bool("strict", false, "exit with a failure when unsupported rules are reported")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprint(stderr, "export requires at least one request schema file\n")
+		return 2
+	}
+
+	schemas := make([]request.Schema, 0, fs.NArg())
+	for _, name := range fs.Args() {
+		schema, err := readSchema(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "schema [%s]: %v\n", name, err)
+			return 1
+		}
+		schemas = append(schemas, schema)
+	}
+
+	w := stdout
+	if len(*out) > 0 {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintf(stderr, "create document: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+	info := openapi.Info{
+		Title:       *title,
+		Description: *description,
+		Version:     *version,
+	}
+	unsupported, err := openapi.ExportJSON(w, info, schemas...)
+	if err != nil {
+		fmt.Fprintf(stderr, "export document: %v\n", err)
+		return 1
+	}
+	for _, u := range unsupported {
+		fmt.Fprintf(stderr, "unsupported: %s: %s\n", u.Location, u.Msg)
+	}
+	if *strict && len(unsupported) > 0 {
+		return 1
+	}
+	return 0
+}
+
+func readSchema(name string) (request.Schema, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return request.Schema{}, err
+	}
+	defer f.Close()
+	return request.SchemaFromJSON(f)
+}
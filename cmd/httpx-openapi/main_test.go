@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request"
+	"github.com/g8rswimmer/httpx/request/openapi"
+)
+
+func TestRun_Import(t *testing.T) {
+	dir := t.TempDir()
+	doc := filepath.Join(dir, "openapi.json")
+	err := os.WriteFile(doc, []byte(`{
+		"openapi": "3.0.3",
+		"info": {"title": "test", "version": "1"},
+		"paths": {
+			"/users/{id}": {
+				"get": {
+					"operationId": "getUser",
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "minimum": 1}}
+					]
+				}
+			}
+		}
+	}`), 0o644)
+	if err != nil {
+		t.Fatalf("write document error = %v", err)
+	}
+	out := filepath.Join(dir, "schemas")
+
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+	}{
+		{
+			name: "success",
+			args: args{
+				args: []string{"import", "-out", out, doc},
+			},
+			wantCode: 0,
+		},
+		{
+			name: "fail: strict",
+			args: args{
+				args: []string{"import", "-strict", "-out", out, doc},
+			},
+			wantCode: 1,
+		},
+		{
+			name: "fail: no document",
+			args: args{
+				args: []string{"import"},
+			},
+			wantCode: 2,
+		},
+		{
+			name: "fail: unknown command",
+			args: args{
+				args: []string{"convert"},
+			},
+			wantCode: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			if code := run(tt.args.args, stdout, stderr); code != tt.wantCode {
+				t.Errorf("run() = %d, want %d: %s", code, tt.wantCode, stderr.String())
+			}
+		})
+	}
+
+	f, err := os.Open(filepath.Join(out, "getUser.json"))
+	if err != nil {
+		t.Fatalf("open schema error = %v", err)
+	}
+	defer f.Close()
+	if _, err := request.SchemaFromJSON(f); err != nil {
+		t.Errorf("request.SchemaFromJSON() error = %v", err)
+	}
+}
+
+func TestRun_Export(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.json")
+	err := os.WriteFile(schema, []byte(`{
+		"title": "get user",
+		"endpoint": {
+			"method": "GET",
+			"endpoint": "/users/{id}",
+			"path_variables": {
+				"{id}": {"validation": {"number_validator": {"min": 1}}}
+			}
+		}
+	}`), 0o644)
+	if err != nil {
+		t.Fatalf("write schema error = %v", err)
+	}
+	out := filepath.Join(dir, "openapi.json")
+
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+	}{
+		{
+			name: "success",
+			args: args{
+				args: []string{"export", "-title", "Users", "-version", "1.0.0", "-out", out, schema},
+			},
+			wantCode: 0,
+		},
+		{
+			name: "fail: no schemas",
+			args: args{
+				args: []string{"export"},
+			},
+			wantCode: 2,
+		},
+		{
+			name: "fail: missing schema",
+			args: args{
+				args: []string{"export", filepath.Join(dir, "missing.json")},
+			},
+			wantCode: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			if code := run(tt.args.args, stdout, stderr); code != tt.wantCode {
+				t.Errorf("run() = %d, want %d: %s", code, tt.wantCode, stderr.String())
+			}
+		})
+	}
+
+	f, err := os.Open(out)
+	if err != nil {
+		t.Fatalf("open document error = %v", err)
+	}
+	defer f.Close()
+	operations, _, err := openapi.ImportJSON(f)
+	if err != nil || len(operations) != 1 {
+		t.Errorf("openapi.ImportJSON() operations = %d, error = %v", len(operations), err)
+	}
+}
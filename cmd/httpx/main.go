@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const usage = `usage: httpx <command> [flags]
+
+commands:
+  lint     check that schema files are valid
+  check    validate a raw HTTP request, or response, file with a schema
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "lint":
+		return runLint(args[1:], stdout, stderr)
+	case "check":
+		return runCheck(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "unknown command [%s]\n%s", args[0], usage)
+		return 2
+	}
+}
+
+func runLint(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	kind := fs.String("kind", "request", fmt.Sprintf("schema kind, one of %v", kinds()))
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprint(stderr, "lint requires at least one schema file\n")
+		return 2
+	}
+
+	code := 0
+	for _, name := range fs.Args() {
+		if _, err := loadSchema(*kind, name); err != nil {
+			fmt.Fprintf(stdout, "%s: %v\n", name, err)
+			code = 1
+			continue
+		}
+		fmt.Fprintf(stdout, "%s: ok\n", name)
+	}
+	return code
+}
+
+func runCheck(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	kind := fs.String("kind", "request", fmt.Sprintf("schema kind, one of %v", kinds()))
+	schemaFile := fs.String("schema", "", "schema file")
+	requestFile := fs.String("request", "", "raw HTTP request file")
+	responseFile := fs.String("response", "", "raw HTTP response file, used with the response kind")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	switch {
+	case len(*schemaFile) == 0:
+		fmt.Fprint(stderr, "check requires a schema file\n")
+		return 2
+	case len(*requestFile) == 0 && len(*responseFile) == 0:
+		fmt.Fprint(stderr, "check requires a request or response file\n")
+		return 2
+	}
+
+	schema, err := loadSchema(*kind, *schemaFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", *schemaFile, err)
+		return 1
+	}
+
+	switch v := schema.(type) {
+	case requestValidator:
+		if len(*requestFile) == 0 {
+			fmt.Fprintf(stderr, "%s schema requires a request file\n", *kind)
+			return 2
+		}
+		req, err := readRequest(*requestFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", *requestFile, err)
+			return 1
+		}
+		return report(v.Validate(req), stdout, stderr)
+	case responseValidator:
+		if len(*responseFile) == 0 {
+			fmt.Fprintf(stderr, "%s schema requires a response file\n", *kind)
+			return 2
+		}
+		resp, err := readResponse(*responseFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", *responseFile, err)
+			return 1
+		}
+		return report(v.Validate(resp), stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "%s schema can not validate\n", *kind)
+		return 2
+	}
+}
+
+// report writes the validation error as JSON.
+func report(err error, stdout, stderr io.Writer) int {
+	if err == nil {
+		fmt.Fprint(stdout, "ok\n")
+		return 0
+	}
+	var schemaErr *rerror.SchemaErr
+	if !errors.As(err, &schemaErr) {
+		schemaErr = &rerror.SchemaErr{
+			Msg: err.Error(),
+			Err: err.Error(),
+		}
+	}
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(schemaErr); err != nil {
+		fmt.Fprintf(stderr, "encode error: %v\n", err)
+	}
+	return 1
+}
+
+// readRequest parses a raw HTTP request file.  When the request does not have
+// a content length the rest of the file is the body.
+func readRequest(name string) (*http.Request, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(bytes.NewReader(data))
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse request: %w", err)
+	}
+	if req.ContentLength == 0 && len(req.TransferEncoding) == 0 {
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+	return req, nil
+}
+
+// readResponse parses a raw HTTP response file.
+func readResponse(name string) (*http.Response, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSchemaJSON = `{
+	"title": "create user",
+	"endpoint": {
+		"method": "POST",
+		"endpoint": "/users"
+	},
+	"body": {
+		"body": {
+			"object": {
+				"required_fields": {"one_of": [["name"]]},
+				"parameters": {
+					"name": {"validation": {"string_validator": {}}}
+				}
+			}
+		}
+	}
+}`
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil {
+			t.Fatalf("mkdir [%s] error = %v", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write [%s] error = %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestRun_Lint(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"schema.json": testSchemaJSON,
+		"unknown.json": `{
+			"endpoint": {"method": "GET", "endpoint": "/users"},
+			"query": {"parameters": {"limit": {"validation": {"number_validatr": {}}}}}
+		}`,
+		"model.json": `{"query": {"parameters": {}}}`,
+		"body.json":  `{"title": "body", "body": {}}`,
+		"users/user.json": `{
+			"body": {
+				"object": {
+					"parameters": {
+						"address": {"validation": {"object_validator": {"$ref": "../address.yaml#/definitions/address"}}}
+					}
+				}
+			}
+		}`,
+		"address.yaml":  "definitions:\n  address:\n    parameters:\n      zip:\n        validation:\n          string_validator: {}\n",
+		"dangling.json": `{"body": {"object": {"$ref": "#/definitions/user"}}}`,
+	})
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantOut  string
+	}{
+		{
+			name: "success",
+			args: args{
+				args: []string{"lint", filepath.Join(dir, "schema.json")},
+			},
+			wantCode: 0,
+			wantOut:  "schema.json: ok",
+		},
+		{
+			name: "fail: unknown key",
+			args: args{
+				args: []string{"lint", filepath.Join(dir, "schema.json"), filepath.Join(dir, "unknown.json")},
+			},
+			wantCode: 1,
+			wantOut:  `unknown field "number_validatr"`,
+		},
+		{
+			name: "fail: model",
+			args: args{
+				args: []string{"lint", filepath.Join(dir, "model.json")},
+			},
+			wantCode: 1,
+			wantOut:  "schema validation",
+		},
+		{
+			name: "fail: jbody",
+			args: args{
+				args: []string{"lint", "-kind", "jbody", filepath.Join(dir, "body.json")},
+			},
+			wantCode: 1,
+			wantOut:  "object or object array",
+		},
+		{
+			name: "fail: kind",
+			args: args{
+				args: []string{"lint", "-kind", "xml", filepath.Join(dir, "schema.json")},
+			},
+			wantCode: 1,
+			wantOut:  "schema kind [xml]",
+		},
+		{
+			name: "success: example references",
+			args: args{
+				args: []string{"lint", "-kind", "jbody", filepath.Join("..", "..", "_examples", "request", "body", "object", "object_schema.json")},
+			},
+			wantCode: 0,
+			wantOut:  "object_schema.json: ok",
+		},
+		{
+			name: "success: file references",
+			args: args{
+				args: []string{"lint", "-kind", "jbody", filepath.Join(dir, "users", "user.json")},
+			},
+			wantCode: 0,
+			wantOut:  "user.json: ok",
+		},
+		{
+			name: "fail: dangling reference",
+			args: args{
+				args: []string{"lint", "-kind", "jbody", filepath.Join(dir, "dangling.json")},
+			},
+			wantCode: 1,
+			wantOut:  "/body/object/$ref",
+		},
+		{
+			name: "fail: usage",
+			args: args{
+				args: []string{"lint"},
+			},
+			wantCode: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			if code := run(tt.args.args, stdout, stderr); code != tt.wantCode {
+				t.Errorf("run() = %d, want %d: %s", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOut) {
+				t.Errorf("run() output = %s, want %s", stdout.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestRun_Check(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"schema.json":  testSchemaJSON,
+		"success.http": "POST /users HTTP/1.1\nHost: www.test.com\nContent-Type: application/json\n\n{\"name\": \"Gary\"}\n",
+		"length.http":  "POST /users HTTP/1.1\r\nHost: www.test.com\r\nContent-Length: 16\r\n\r\n{\"name\": \"Gary\"}",
+		"fail.http":    "POST /users HTTP/1.1\nHost: www.test.com\n\n{\"name\": \"\"}\n",
+		"method.http":  "GET /users HTTP/1.1\nHost: www.test.com\n\n",
+		"address.http": "POST /users HTTP/1.1\nHost: www.test.com\n\n{\"first_name\": \"Gary\", \"last_name\": \"Smith\", \"date_of_birth\": \"2000-01-02\", \"home_address\": {\"street_1\": \"1 Main St\", \"zip\": \"60601\"}}\n",
+		"response.json": `{
+			"status_codes": [200],
+			"body": {
+				"object": {
+					"parameters": {
+						"id": {"validation": {"number_validator": {}}}
+					}
+				}
+			}
+		}`,
+		"response.http": "HTTP/1.1 200 OK\nContent-Type: application/json\n\n{\"id\": \"one\"}\n",
+		"content.json": `{
+			"default": "application/json",
+			"media_types": {
+				"application/json": {
+					"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}
+				},
+				"application/x-www-form-urlencoded": {
+					"form": {"parameters": {"name": {"validation": {"string_validator": {}}}}}
+				}
+			}
+		}`,
+		"form.http":  "POST /users HTTP/1.1\nHost: www.test.com\nContent-Type: application/x-www-form-urlencoded\n\nname=Gary",
+		"plain.http": "POST /users HTTP/1.1\nHost: www.test.com\nContent-Type: text/plain\n\nGary",
+	})
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantOut  string
+	}{
+		{
+			name: "success",
+			args: args{
+				args: []string{"check", "-schema", filepath.Join(dir, "schema.json"), "-request", filepath.Join(dir, "success.http")},
+			},
+			wantCode: 0,
+			wantOut:  "ok",
+		},
+		{
+			name: "success: content length",
+			args: args{
+				args: []string{"check", "-schema", filepath.Join(dir, "schema.json"), "-request", filepath.Join(dir, "length.http")},
+			},
+			wantCode: 0,
+			wantOut:  "ok",
+		},
+		{
+			name: "fail: body",
+			args: args{
+				args: []string{"check", "-schema", filepath.Join(dir, "schema.json"), "-request", filepath.Join(dir, "fail.http")},
+			},
+			wantCode: 1,
+			wantOut:  `"message": "value must be present"`,
+		},
+		{
+			name: "fail: method",
+			args: args{
+				args: []string{"check", "-schema", filepath.Join(dir, "schema.json"), "-request", filepath.Join(dir, "method.http")},
+			},
+			wantCode: 1,
+			wantOut:  "request ednpoint validation",
+		},
+		{
+			name: "success: example references",
+			args: args{
+				args: []string{"check", "-kind", "jbody", "-schema", filepath.Join("..", "..", "_examples", "request", "body", "object", "object_schema.json"), "-request", filepath.Join(dir, "address.http")},
+			},
+			wantCode: 0,
+			wantOut:  "ok",
+		},
+		{
+			name: "success: content",
+			args: args{
+				args: []string{"check", "-kind", "content", "-schema", filepath.Join(dir, "content.json"), "-request", filepath.Join(dir, "form.http")},
+			},
+			wantCode: 0,
+			wantOut:  "ok",
+		},
+		{
+			name: "fail: content media type",
+			args: args{
+				args: []string{"check", "-kind", "content", "-schema", filepath.Join(dir, "content.json"), "-request", filepath.Join(dir, "plain.http")},
+			},
+			wantCode: 1,
+			wantOut:  "request media type [text/plain] is not supported",
+		},
+		{
+			name: "fail: response",
+			args: args{
+				args: []string{"check", "-kind", "response", "-schema", filepath.Join(dir, "response.json"), "-response", filepath.Join(dir, "response.http")},
+			},
+			wantCode: 1,
+			wantOut:  "response json body validation",
+		},
+		{
+			name: "fail: usage",
+			args: args{
+				args: []string{"check", "-schema", filepath.Join(dir, "schema.json")},
+			},
+			wantCode: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			if code := run(tt.args.args, stdout, stderr); code != tt.wantCode {
+				t.Errorf("run() = %d, want %d: %s", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantOut) {
+				t.Errorf("run() output = %s, want %s", stdout.String(), tt.wantOut)
+			}
+		})
+	}
+}
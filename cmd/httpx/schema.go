@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/g8rswimmer/httpx/internal/schemaref"
+	"github.com/g8rswimmer/httpx/request"
+	"github.com/g8rswimmer/httpx/request/cookie"
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/form"
+	"github.com/g8rswimmer/httpx/request/header"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/mbody"
+	"github.com/g8rswimmer/httpx/request/query"
+	"github.com/g8rswimmer/httpx/request/xbody"
+	"github.com/g8rswimmer/httpx/response"
+)
+
+type requestValidator interface {
+	Validate(req *http.Request) error
+}
+
+type responseValidator interface {
+	Validate(resp *http.Response) error
+}
+
+// loaders decode a schema file of the kind, unknown keys are an error so
+// misspelled rules are not silently ignored.
+var loaders = map[string]func(data []byte) (any, error){
+	"request": func(data []byte) (any, error) {
+		return load(data, request.SchemaModelValidator)
+	},
+	"endpoint": func(data []byte) (any, error) {
+		return load(data, endpoint.SchemaModelValidator)
+	},
+	"query": func(data []byte) (any, error) {
+		return load(data, query.SchemaModelValidator)
+	},
+	"header": func(data []byte) (any, error) {
+		return load(data, header.SchemaModelValidator)
+	},
+	"cookie": func(data []byte) (any, error) {
+		return load(data, cookie.SchemaModelValidator)
+	},
+	"form": func(data []byte) (any, error) {
+		return load(data, form.SchemaModelValidator)
+	},
+	"jbody": func(data []byte) (any, error) {
+		return load(data, jbodyModelValidator)
+	},
+	"mbody": func(data []byte) (any, error) {
+		return load(data, mbody.SchemaModelValidator)
+	},
+	"xbody": func(data []byte) (any, error) {
+		return load(data, xbody.SchemaModelValidator)
+	},
+	"content": func(data []byte) (any, error) {
+		return load(data, request.SchemaModelContentValidator)
+	},
+	"response": func(data []byte) (any, error) {
+		return load(data, response.SchemaModelValidator)
+	},
+}
+
+func kinds() []string {
+	names := make([]string, 0, len(loaders))
+	for name := range loaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadSchema loads the schema file of the kind.  The definitions and
+// references, including the references to other files, are resolved before
+// the schema is decoded.
+func loadSchema(kind string, name string) (any, error) {
+	loader, has := loaders[kind]
+	if !has {
+		return nil, fmt.Errorf("schema kind [%s] must be one of %v", kind, kinds())
+	}
+	data, err := resolveSchema(name)
+	if err != nil {
+		return nil, err
+	}
+	return loader(data)
+}
+
+// resolveSchema resolves the schema file from the file system root, so the
+// references to files outside of the schema directory can be resolved.
+func resolveSchema(name string) ([]byte, error) {
+	data, err := schemaref.ResolveFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("schema resolve: %w", err)
+	}
+	return data, nil
+}
+
+func load[T any](data []byte, modelValidator func(T) error) (T, error) {
+	var schema T
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&schema); err != nil {
+		return schema, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := modelValidator(schema); err != nil {
+		return schema, fmt.Errorf("schema validation: %w", err)
+	}
+	return schema, nil
+}
+
+func jbodyModelValidator(schema jbody.Schema) error {
+	switch {
+	case schema.Body.Object != nil && schema.Body.ObjectArray != nil:
+		return errors.New("schema body can not be an object AND object array")
+	case schema.Body.Object == nil && schema.Body.ObjectArray == nil:
+		return errors.New("schema body must be an object or object array")
+	default:
+		return jbody.Lint(schema)
+	}
+}
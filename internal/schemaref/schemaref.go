@@ -0,0 +1,340 @@
+// Package schemaref resolves the schema references, an object with only a
+// "$ref" key is replaced by the value it refers to.
+//
+//	{"$ref": "#/definitions/address"}
+//	{"$ref": "common.json#/definitions/money"}
+//	{"$ref": "pagination.yaml"}
+//
+// The file of a reference is relative to the file of the reference, and the
+// fragment is a JSON Pointer in the file.
+package schemaref
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/internal/yamljson"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// maxExpanded bounds the values of the resolved schema, a reference that is
+// used more than once is expanded each time it is used.
+const maxExpanded = 100000
+
+const (
+	// Key is the object key of a reference.
+	Key = "$ref"
+	// Definitions is the schema key of the named definitions, it is removed
+	// from the resolved schema.
+	Definitions = "definitions"
+)
+
+// Decode decodes the JSON schema into v, the references must be in the
+// schema.
+func Decode(reader io.Reader, v any) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	data, err = Resolve(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// DecodeYAML decodes the YAML schema into v, the references must be in the
+// schema.  The document locates the decode errors when the schema does not
+// have references.
+func DecodeYAML(reader io.Reader, v any) (*yamljson.Document, error) {
+	doc, err := yamljson.Parse(reader)
+	if err != nil {
+		return nil, err
+	}
+	data, err := Resolve(doc.JSON)
+	if err != nil {
+		return nil, doc.Locate(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		if bytes.Equal(data, doc.JSON) {
+			return nil, doc.Locate(err)
+		}
+		return nil, err
+	}
+	return doc, nil
+}
+
+// DecodeFS decodes the schema file of the file system into v, a file with
+// a .yaml or .yml extension is YAML and any other file is JSON.
+func DecodeFS(fsys fs.FS, name string, v any) error {
+	data, err := ResolveFS(fsys, name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Resolve returns the JSON schema with the references resolved, the schema is
+// returned as is when it does not have a reference.
+func Resolve(data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte(Key)) {
+		return data, nil
+	}
+	doc, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	r := newResolver(nil, "")
+	r.docs[""] = doc
+	return r.resolve()
+}
+
+// ResolveFS returns the schema file of the file system as JSON with the
+// references resolved.
+func ResolveFS(fsys fs.FS, name string) ([]byte, error) {
+	r := newResolver(fsys, name)
+	if _, err := r.document(name); err != nil {
+		return nil, err
+	}
+	return r.resolve()
+}
+
+// ResolveFile returns the schema file of the operating system as JSON with
+// the references resolved.  The file system is the root of the file, so the
+// references to files outside of the schema directory can be resolved.
+func ResolveFile(name string) ([]byte, error) {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(abs); err != nil {
+		return nil, err
+	}
+	root := filepath.VolumeName(abs) + string(filepath.Separator)
+	rel := filepath.ToSlash(strings.TrimPrefix(abs, root))
+	return ResolveFS(os.DirFS(root), rel)
+}
+
+type resolver struct {
+	fsys     fs.FS
+	root     string
+	docs     map[string]any
+	done     map[string]any
+	sizes    map[string]int
+	expanded int
+	stack    []string
+	issues   []rerror.LintIssue
+}
+
+func newResolver(fsys fs.FS, root string) *resolver {
+	return &resolver{
+		fsys:  fsys,
+		root:  root,
+		docs:  map[string]any{},
+		done:  map[string]any{},
+		sizes: map[string]int{},
+	}
+}
+
+// spend adds n values to the expanded values, false is returned once the
+// expanded values are greater than the max.
+func (r *resolver) spend(file, pointer string, n int) bool {
+	if r.expanded > maxExpanded {
+		return false
+	}
+	r.expanded += n
+	if r.expanded <= maxExpanded {
+		return true
+	}
+	r.issues = append(r.issues, rerror.LintIssue{
+		Path: r.location(file, pointer),
+		Msg:  fmt.Sprintf("references expand to more than %d values", maxExpanded),
+	})
+	return false
+}
+
+func (r *resolver) resolve() ([]byte, error) {
+	v := r.value(r.root, "", r.docs[r.root])
+	if err := rerror.LintFromIssues("schema reference", r.issues); err != nil {
+		return nil, err
+	}
+	if m, ok := v.(map[string]any); ok {
+		delete(m, Definitions)
+	}
+	return json.Marshal(v)
+}
+
+func (r *resolver) document(name string) (any, error) {
+	if doc, has := r.docs[name]; has {
+		return doc, nil
+	}
+	data, err := fs.ReadFile(r.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	if ext := path.Ext(name); ext == ".yaml" || ext == ".yml" {
+		yamlDoc, err := yamljson.Parse(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		data = yamlDoc.JSON
+	}
+	doc, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	r.docs[name] = doc
+	return doc, nil
+}
+
+func (r *resolver) value(file, pointer string, v any) any {
+	if !r.spend(file, pointer, 1) {
+		return nil
+	}
+	switch t := v.(type) {
+	case map[string]any:
+		if ref, has := t[Key]; has {
+			return r.ref(file, pointer, t, ref)
+		}
+		keys := make([]string, 0, len(t))
+		for key := range t {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		m := make(map[string]any, len(t))
+		for _, key := range keys {
+			m[key] = r.value(file, pointer+"/"+rerror.PointerToken(key), t[key])
+		}
+		return m
+	case []any:
+		s := make([]any, len(t))
+		for i, child := range t {
+			s[i] = r.value(file, pointer+"/"+strconv.Itoa(i), child)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func (r *resolver) ref(file, pointer string, obj map[string]any, ref any) any {
+	location := r.location(file, pointer+"/"+rerror.PointerToken(Key))
+	issue := func(format string, args ...any) any {
+		r.issues = append(r.issues, rerror.LintIssue{
+			Path: location,
+			Msg:  fmt.Sprintf(format, args...),
+		})
+		return nil
+	}
+	s, ok := ref.(string)
+	switch {
+	case !ok:
+		return issue("reference must be a string")
+	case len(obj) != 1:
+		return issue("reference [%s] can not have other keys", s)
+	default:
+	}
+
+	target, fragment, _ := strings.Cut(s, "#")
+	targetFile := file
+	if len(target) > 0 {
+		if r.fsys == nil {
+			return issue("reference [%s] to a file needs the schema to be loaded from a file system", s)
+		}
+		targetFile = path.Join(path.Dir(file), target)
+		if !fs.ValidPath(targetFile) {
+			return issue("reference [%s] is outside of the file system", s)
+		}
+	}
+
+	key := targetFile + "#" + fragment
+	if resolved, has := r.done[key]; has {
+		if !r.spend(file, pointer, r.sizes[key]) {
+			return nil
+		}
+		return resolved
+	}
+	for i, k := range r.stack {
+		if k == key {
+			return issue("reference cycle %s", strings.Join(append(r.stack[i:], key), " -> "))
+		}
+	}
+	doc, err := r.document(targetFile)
+	if err != nil {
+		return issue("reference [%s] file: %v", s, err)
+	}
+	v, err := lookup(doc, fragment)
+	if err != nil {
+		return issue("reference [%s] %v", s, err)
+	}
+
+	r.stack = append(r.stack, key)
+	expanded := r.expanded
+	resolved := r.value(targetFile, fragment, v)
+	r.stack = r.stack[:len(r.stack)-1]
+	if m, ok := resolved.(map[string]any); ok && len(fragment) == 0 {
+		delete(m, Definitions)
+	}
+	r.done[key] = resolved
+	r.sizes[key] = r.expanded - expanded
+	return resolved
+}
+
+// location is the JSON Pointer of the root file, and the file and JSON
+// Pointer of the other files.
+func (r *resolver) location(file, pointer string) string {
+	if file == r.root {
+		return pointer
+	}
+	return file + "#" + pointer
+}
+
+func lookup(doc any, pointer string) (any, error) {
+	if len(pointer) == 0 {
+		return doc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.New("fragment must be a JSON Pointer")
+	}
+	v := doc
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch t := v.(type) {
+		case map[string]any:
+			child, has := t[token]
+			if !has {
+				return nil, fmt.Errorf("[%s] is not defined", token)
+			}
+			v = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil, fmt.Errorf("[%s] is not an array index", token)
+			}
+			v = t[i]
+		default:
+			return nil, fmt.Errorf("[%s] is not defined", token)
+		}
+	}
+	return v, nil
+}
+
+func decode(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
@@ -0,0 +1,222 @@
+package schemaref
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestResolve(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr []string
+	}{
+		{
+			name: "success: no references",
+			args: args{
+				schema: `{"definitions": {"name": {}}, "name": {"value": 1.50}}`,
+			},
+			want: `{"definitions": {"name": {}}, "name": {"value": 1.50}}`,
+		},
+		{
+			name: "success: definitions",
+			args: args{
+				schema: `{
+					"definitions": {
+						"zip": {"regex": "^[0-9]{5}$"},
+						"address": {"zip": {"$ref": "#/definitions/zip"}, "max": 1.50}
+					},
+					"home": {"$ref": "#/definitions/address"},
+					"other": [{"$ref": "#/definitions/address"}]
+				}`,
+			},
+			want: `{
+				"home": {"zip": {"regex": "^[0-9]{5}$"}, "max": 1.50},
+				"other": [{"zip": {"regex": "^[0-9]{5}$"}, "max": 1.50}]
+			}`,
+		},
+		{
+			name: "fail: dangling",
+			args: args{
+				schema: `{
+					"definitions": {"address": {}},
+					"home": {"$ref": "#/definitions/adress"},
+					"other": {"$ref": "#definitions"}
+				}`,
+			},
+			wantErr: []string{"/home/$ref", "/other/$ref"},
+		},
+		{
+			name: "fail: cycle",
+			args: args{
+				schema: `{
+					"definitions": {
+						"a": {"b": {"$ref": "#/definitions/b"}},
+						"b": {"a": {"$ref": "#/definitions/a"}}
+					},
+					"home": {"$ref": "#/definitions/a"}
+				}`,
+			},
+			wantErr: []string{"/definitions/a/b/$ref"},
+		},
+		{
+			name: "fail: reference",
+			args: args{
+				schema: `{
+					"home": {"$ref": "address.json#/definitions/address"},
+					"other": {"$ref": 1},
+					"work": {"$ref": "#", "max": 1}
+				}`,
+			},
+			wantErr: []string{"/home/$ref", "/other/$ref", "/work/$ref"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve([]byte(tt.args.schema))
+			if tt.wantErr != nil {
+				assertIssues(t, err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			assertJSON(t, got, tt.want)
+		})
+	}
+}
+
+func TestResolve_Expansion(t *testing.T) {
+	definitions := []string{`"d20": {"max": 1}`}
+	for i := 0; i < 20; i++ {
+		definitions = append(definitions, fmt.Sprintf(`"d%d": [{"$ref": "#/definitions/d%d"}, {"$ref": "#/definitions/d%d"}]`, i, i+1, i+1))
+	}
+	schema := `{"definitions": {` + strings.Join(definitions, ", ") + `}, "home": {"$ref": "#/definitions/d0"}}`
+	_, err := Resolve([]byte(schema))
+	var lintErr *rerror.LintErr
+	if !errors.As(err, &lintErr) || len(lintErr.Issues) != 1 {
+		t.Fatalf("Resolve() error = %v, want one issue", err)
+	}
+	if !strings.Contains(lintErr.Issues[0].Msg, "references expand to more than") {
+		t.Errorf("Resolve() issue = %v", lintErr.Issues[0])
+	}
+}
+
+func TestResolveFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"users/user.json": {Data: []byte(`{
+			"definitions": {"name": {"string_validator": {}}},
+			"name": {"$ref": "#/definitions/name"},
+			"home": {"$ref": "../common/address.json#/definitions/address"},
+			"price": {"$ref": "../common/money.yaml#/definitions/money"},
+			"page": {"$ref": "../common/page.json"}
+		}`)},
+		"common/address.json": {Data: []byte(`{
+			"definitions": {
+				"address": {"zip": {"$ref": "#/definitions/zip"}},
+				"zip": {"string_validator": {"regex": "^[0-9]{5}$"}}
+			}
+		}`)},
+		"common/money.yaml": {Data: []byte("definitions:\n  money:\n    number_validator:\n      min: 0\n")},
+		"common/page.json":  {Data: []byte(`{"definitions": {"max": 100}, "limit": {"max": {"$ref": "#/definitions/max"}}}`)},
+		"cycle/a.json":      {Data: []byte(`{"a": {"$ref": "b.json#/b"}}`)},
+		"cycle/b.json":      {Data: []byte(`{"b": {"$ref": "a.json#/a"}}`)},
+		"dangling.json": {Data: []byte(`{
+			"home": {"$ref": "common/address.json#/definitions/street"},
+			"other": {"$ref": "common/missing.json"},
+			"work": {"$ref": "../address.json"}
+		}`)},
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr []string
+	}{
+		{
+			name: "success",
+			args: args{
+				name: "users/user.json",
+			},
+			want: `{
+				"name": {"string_validator": {}},
+				"home": {"zip": {"string_validator": {"regex": "^[0-9]{5}$"}}},
+				"price": {"number_validator": {"min": 0}},
+				"page": {"limit": {"max": 100}}
+			}`,
+		},
+		{
+			name: "fail: cycle",
+			args: args{
+				name: "cycle/a.json",
+			},
+			wantErr: []string{"/a/$ref"},
+		},
+		{
+			name: "fail: dangling",
+			args: args{
+				name: "dangling.json",
+			},
+			wantErr: []string{"/home/$ref", "/other/$ref", "/work/$ref"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveFS(fsys, tt.args.name)
+			if tt.wantErr != nil {
+				assertIssues(t, err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveFS() error = %v", err)
+			}
+			assertJSON(t, got, tt.want)
+		})
+	}
+	if _, err := ResolveFS(fsys, "missing.json"); err == nil {
+		t.Errorf("ResolveFS() missing file error = nil")
+	}
+}
+
+func assertIssues(t *testing.T, err error, want []string) {
+	t.Helper()
+	var lintErr *rerror.LintErr
+	if !errors.As(err, &lintErr) {
+		t.Fatalf("error = %v, want a lint error", err)
+	}
+	got := []string{}
+	for _, issue := range lintErr.Issues {
+		got = append(got, issue.Path)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("issue paths = %v, want %v", got, want)
+	}
+}
+
+func assertJSON(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var g, w any
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("resolved json error = %v", err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("want json error = %v", err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("resolved = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,343 @@
+// Package yamljson decodes YAML schema files with the json struct tags of
+// the schemas, so a YAML schema is the same as its JSON schema.
+package yamljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+	"gopkg.in/yaml.v3"
+)
+
+// Document is a YAML document converted to JSON, with the line and column
+// of each value.
+type Document struct {
+	JSON     []byte
+	spans    []span
+	pointers map[string]position
+}
+
+type position struct {
+	line   int
+	column int
+}
+
+// span is the JSON bytes of a value.
+type span struct {
+	start int
+	end   int
+	position
+}
+
+// Decode decodes the first YAML document of the reader into v, with the json
+// struct tags of v.  The decode errors have the line and column of the value.
+func Decode(reader io.Reader, v any) (*Document, error) {
+	doc, err := Parse(reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(doc.JSON, v); err != nil {
+		return nil, doc.Locate(err)
+	}
+	return doc, nil
+}
+
+// maxExpanded is the number of values the aliases and merge keys can expand
+// to, over the number of values in the document, so a small document can not
+// expand exponentially.
+const maxExpanded = 100000
+
+// Parse converts the first YAML document of the reader to JSON.
+func Parse(reader io.Reader) (*Document, error) {
+	var root yaml.Node
+	if err := yaml.NewDecoder(reader).Decode(&root); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("yaml document is empty")
+		}
+		return nil, err
+	}
+	c := &converter{
+		doc: &Document{
+			pointers: map[string]position{},
+		},
+		aliases: map[*yaml.Node]bool{},
+		budget:  count(&root) + maxExpanded,
+	}
+	if err := c.value(&root, ""); err != nil {
+		return nil, err
+	}
+	c.doc.JSON = c.buf.Bytes()
+	return c.doc, nil
+}
+
+// Locate adds the line and column of the value to a decode error, and sets
+// them on the lint issues of a lint error.  An issue without a value at its
+// path has the position of its nearest parent.
+func (d *Document) Locate(err error) error {
+	var lintErr *rerror.LintErr
+	if !errors.As(err, &lintErr) {
+		return d.locateDecode(err)
+	}
+	for i, issue := range lintErr.Issues {
+		if pos, has := d.position(issue.Path); has {
+			lintErr.Issues[i].Line = pos.line
+			lintErr.Issues[i].Column = pos.column
+		}
+	}
+	return err
+}
+
+func (d *Document) position(pointer string) (position, bool) {
+	for {
+		if pos, has := d.pointers[pointer]; has {
+			return pos, true
+		}
+		i := strings.LastIndex(pointer, "/")
+		if i < 0 {
+			return position{}, false
+		}
+		pointer = pointer[:i]
+	}
+}
+
+func (d *Document) locateDecode(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return err
+	}
+	// the offset is just past the value, or just past the opening of an
+	// object or array, so the innermost value holding the byte before it is
+	// the value that could not be decoded.
+	offset := int(typeErr.Offset) - 1
+	var found *span
+	for i := range d.spans {
+		s := &d.spans[i]
+		if s.start <= offset && offset < s.end && (found == nil || s.start >= found.start) {
+			found = s
+		}
+	}
+	if found == nil {
+		return err
+	}
+	return fmt.Errorf("line %d, column %d: %w", found.line, found.column, err)
+}
+
+type converter struct {
+	buf     bytes.Buffer
+	doc     *Document
+	aliases map[*yaml.Node]bool
+	budget  int
+}
+
+// count returns the number of values in the document, without following the
+// aliases.
+func count(node *yaml.Node) int {
+	n := 1
+	for _, child := range node.Content {
+		n += count(child)
+	}
+	return n
+}
+
+// spend takes the values from the budget.
+func (c *converter) spend(node *yaml.Node, values int) error {
+	c.budget -= values
+	if c.budget < 0 {
+		return fmt.Errorf("yaml: line %d, column %d: excessive aliasing", node.Line, node.Column)
+	}
+	return nil
+}
+
+type entry struct {
+	key   *yaml.Node
+	value *yaml.Node
+}
+
+func (c *converter) value(node *yaml.Node, pointer string) error {
+	if err := c.spend(node, 1); err != nil {
+		return err
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return errors.New("yaml document is empty")
+		}
+		return c.value(node.Content[0], pointer)
+	case yaml.AliasNode:
+		if c.aliases[node.Alias] {
+			return fmt.Errorf("yaml: line %d: alias [%s] refers to itself", node.Line, node.Value)
+		}
+		c.aliases[node.Alias] = true
+		defer delete(c.aliases, node.Alias)
+		return c.value(node.Alias, pointer)
+	default:
+	}
+
+	start := c.buf.Len()
+	var err error
+	switch node.Kind {
+	case yaml.MappingNode:
+		err = c.mapping(node, pointer)
+	case yaml.SequenceNode:
+		err = c.sequence(node, pointer)
+	case yaml.ScalarNode:
+		err = c.scalar(node)
+	default:
+		err = fmt.Errorf("yaml: line %d: unsupported node", node.Line)
+	}
+	if err != nil {
+		return err
+	}
+	c.doc.spans = append(c.doc.spans, span{
+		start: start,
+		end:   c.buf.Len(),
+		position: position{
+			line:   node.Line,
+			column: node.Column,
+		},
+	})
+	if _, has := c.doc.pointers[pointer]; !has {
+		c.doc.pointers[pointer] = position{line: node.Line, column: node.Column}
+	}
+	return nil
+}
+
+func (c *converter) mapping(node *yaml.Node, pointer string) error {
+	entries, err := c.entries(node)
+	if err != nil {
+		return err
+	}
+	c.buf.WriteByte('{')
+	for i, e := range entries {
+		if i > 0 {
+			c.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.key.Value)
+		c.buf.Write(key)
+		c.buf.WriteByte(':')
+		p := pointer + "/" + rerror.PointerToken(e.key.Value)
+		c.doc.pointers[p] = position{line: e.key.Line, column: e.key.Column}
+		if err := c.value(e.value, p); err != nil {
+			return err
+		}
+	}
+	c.buf.WriteByte('}')
+	return nil
+}
+
+// entries returns the mapping keys and values with the merge keys expanded,
+// the mapping keys override the merged keys.
+func (c *converter) entries(node *yaml.Node) ([]entry, error) {
+	entries := []entry{}
+	keys := map[string]bool{}
+	merged := []*yaml.Node{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Kind == yaml.AliasNode {
+			key = key.Alias
+		}
+		if key.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("yaml: line %d: mapping key must be a scalar", key.Line)
+		}
+		if key.ShortTag() == "!!merge" {
+			merged = append(merged, value)
+			continue
+		}
+		if keys[key.Value] {
+			return nil, fmt.Errorf("yaml: line %d: mapping key [%s] already defined", key.Line, key.Value)
+		}
+		keys[key.Value] = true
+		entries = append(entries, entry{key: key, value: value})
+	}
+	for _, value := range merged {
+		mappings := []*yaml.Node{value}
+		if value.Kind == yaml.SequenceNode {
+			mappings = value.Content
+		}
+		for _, mapping := range mappings {
+			if mapping.Kind == yaml.AliasNode {
+				mapping = mapping.Alias
+			}
+			if mapping.Kind != yaml.MappingNode {
+				return nil, fmt.Errorf("yaml: line %d: merge value must be a mapping", mapping.Line)
+			}
+			if c.aliases[mapping] {
+				return nil, fmt.Errorf("yaml: line %d: merge refers to itself", mapping.Line)
+			}
+			if err := c.spend(mapping, len(mapping.Content)/2); err != nil {
+				return nil, err
+			}
+			c.aliases[mapping] = true
+			mergedEntries, err := c.entries(mapping)
+			delete(c.aliases, mapping)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range mergedEntries {
+				if keys[e.key.Value] {
+					continue
+				}
+				keys[e.key.Value] = true
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries, nil
+}
+
+func (c *converter) sequence(node *yaml.Node, pointer string) error {
+	c.buf.WriteByte('[')
+	for i, item := range node.Content {
+		if i > 0 {
+			c.buf.WriteByte(',')
+		}
+		if err := c.value(item, fmt.Sprintf("%s/%d", pointer, i)); err != nil {
+			return err
+		}
+	}
+	c.buf.WriteByte(']')
+	return nil
+}
+
+func (c *converter) scalar(node *yaml.Node) error {
+	var value any
+	switch node.ShortTag() {
+	case "!!null":
+		value = nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return err
+		}
+		value = b
+	case "!!int":
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+	case "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return err
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return fmt.Errorf("yaml: line %d: [%s] is not a JSON number", node.Line, node.Value)
+		}
+		value = f
+	default:
+		// strings, and the timestamps and binary values that are strings in
+		// JSON.
+		value = node.Value
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("yaml: line %d: %w", node.Line, err)
+	}
+	c.buf.Write(b)
+	return nil
+}
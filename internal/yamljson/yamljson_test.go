@@ -0,0 +1,284 @@
+package yamljson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestParse(t *testing.T) {
+	type args struct {
+		yaml string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "success: scalars",
+			args: args{
+				yaml: `
+name: gary
+quoted: "29"
+age: 29
+hex: 0x1F
+ratio: 1.5
+married: true
+children: ~
+born: 2000-01-02
+`,
+			},
+			want:    `{"name":"gary","quoted":"29","age":29,"hex":31,"ratio":1.5,"married":true,"children":null,"born":"2000-01-02"}`,
+			wantErr: false,
+		},
+		{
+			name: "success: sequences",
+			args: args{
+				yaml: `
+one_of:
+  - [first_name, last_name]
+  - - age
+`,
+			},
+			want:    `{"one_of":[["first_name","last_name"],["age"]]}`,
+			wantErr: false,
+		},
+		{
+			name: "success: anchors and merge keys",
+			args: args{
+				yaml: `
+name: &name
+  string_validator:
+    regex: "^[a-z]+$"
+base: &base
+  min: 1
+  max: 10
+first_name: *name
+age:
+  <<: *base
+  max: 100
+`,
+			},
+			want:    `{"name":{"string_validator":{"regex":"^[a-z]+$"}},"base":{"min":1,"max":10},"first_name":{"string_validator":{"regex":"^[a-z]+$"}},"age":{"max":100,"min":1}}`,
+			wantErr: false,
+		},
+		{
+			name: "fail: empty",
+			args: args{
+				yaml: "",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: syntax",
+			args: args{
+				yaml: "name: [gary",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: duplicate key",
+			args: args{
+				yaml: "name: gary\nname: smith\n",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: mapping key",
+			args: args{
+				yaml: "[name]: gary\n",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: infinity",
+			args: args{
+				yaml: "max: .inf\n",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.args.yaml))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got.JSON) != tt.want {
+				t.Errorf("Parse() = %s, want %s", got.JSON, tt.want)
+			}
+			if !json.Valid(got.JSON) {
+				t.Errorf("Parse() = %s is not valid JSON", got.JSON)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	type schema struct {
+		Name  string `json:"name"`
+		Items []struct {
+			Max int `json:"max"`
+		} `json:"items"`
+	}
+	type args struct {
+		yaml string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    schema
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				yaml: "name: gary\nitems:\n  - max: 10\n",
+			},
+			want: schema{
+				Name: "gary",
+				Items: []struct {
+					Max int `json:"max"`
+				}{
+					{Max: 10},
+				},
+			},
+		},
+		{
+			name: "fail: scalar type",
+			args: args{
+				yaml: "name: gary\nitems:\n  - max: ten\n",
+			},
+			wantErr: "line 3, column 10:",
+		},
+		{
+			name: "fail: object type",
+			args: args{
+				yaml: "name:\n  first: gary\n",
+			},
+			wantErr: "line 2, column 3:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got schema
+			_, err := Decode(strings.NewReader(tt.args.yaml), &got)
+			if len(tt.wantErr) > 0 {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("Decode() error = %v, want prefix %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocument_Locate(t *testing.T) {
+	doc, err := Parse(strings.NewReader(`
+parameters:
+  a/b:
+    validation:
+      string_validator:
+        value: gary
+        regex: "^[A-Z]"
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	err = doc.Locate(rerror.LintFromIssues("lint", []rerror.LintIssue{
+		{Path: "/parameters/a~1b/validation/string_validator/value"},
+		{Path: "/parameters/a~1b/validation/number_validator"},
+		{Path: "/missing"},
+	}))
+	var lintErr *rerror.LintErr
+	if !errors.As(err, &lintErr) {
+		t.Fatalf("Locate() error = %v, want a lint error", err)
+	}
+	got := [][2]int{}
+	for _, issue := range lintErr.Issues {
+		got = append(got, [2]int{issue.Line, issue.Column})
+	}
+	want := [][2]int{{6, 9}, {4, 5}, {2, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Locate() positions = %v, want %v", got, want)
+	}
+}
+
+func TestParse_ExcessiveAliasing(t *testing.T) {
+	aliases := func(name, alias string) string {
+		return fmt.Sprintf("%s: &%s [%s]\n", name, name, strings.TrimSuffix(strings.Repeat(alias+", ", 10), ", "))
+	}
+	merges := func(name, alias string) string {
+		return fmt.Sprintf("%s: &%s\n  %s: 1\n  <<: [%s]\n", name, name, name, strings.TrimSuffix(strings.Repeat(alias+", ", 10), ", "))
+	}
+	type args struct {
+		yaml string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success: aliases",
+			args: args{
+				yaml: "a: &a [x, x, x, x, x, x, x, x, x, x]\n" + aliases("b", "*a") + aliases("c", "*b"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: aliases",
+			args: args{
+				yaml: func() string {
+					doc := "a: &a [x, x, x, x, x, x, x, x, x, x]\n"
+					for i, name := range []string{"b", "c", "d", "e", "f", "g", "h", "i"} {
+						doc += aliases(name, "*"+string(rune('a'+i)))
+					}
+					return doc
+				}(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: merge keys",
+			args: args{
+				yaml: func() string {
+					doc := "a: &a\n  a: 1\n"
+					for i, name := range []string{"b", "c", "d", "e", "f", "g", "h", "i"} {
+						doc += merges(name, "*"+string(rune('a'+i)))
+					}
+					return doc
+				}(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.args.yaml))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !regexp.MustCompile(`line \d+, column \d+: excessive aliasing`).MatchString(err.Error()) {
+				t.Errorf("Parse() error = %v, want a located excessive aliasing error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package request
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// CompiledSchema is a schema with all of the section validators compiled, it
+// is safe for concurrent use.
+type CompiledSchema struct {
+	schema   Schema
+	sections []compiledSection
+}
+
+type compiledSection interface {
+	Validate(req *http.Request) error
+}
+
+// Compile returns the schema with the reg exps compiled, the time bounds
+// parsed and the one of values in sets.  All of the compile errors, from
+// every section, are returned.
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := &CompiledSchema{
+		schema: s,
+	}
+	issues := []rerror.LintIssue{}
+	add := func(path string, section compiledSection, err error) {
+		var lintErr *rerror.LintErr
+		switch {
+		case errors.As(err, &lintErr):
+			for _, issue := range lintErr.Issues {
+				issues = append(issues, rerror.LintIssue{
+					Path: path + issue.Path,
+					Msg:  issue.Msg,
+				})
+			}
+		case err != nil:
+			issues = append(issues, rerror.LintIssue{
+				Path: path,
+				Msg:  err.Error(),
+			})
+		default:
+			compiled.sections = append(compiled.sections, section)
+		}
+	}
+	if s.Endpoint != nil {
+		section, err := s.Endpoint.Compile()
+		add("/endpoint", section, err)
+	}
+	if s.Query != nil {
+		section, err := s.Query.Compile()
+		add("/query", section, err)
+	}
+	if s.Header != nil {
+		section, err := s.Header.Compile()
+		add("/header", section, err)
+	}
+	if s.Cookie != nil {
+		section, err := s.Cookie.Compile()
+		add("/cookie", section, err)
+	}
+	if s.Body != nil {
+		section, err := s.Body.Compile()
+		add("/body", section, err)
+	}
+	if s.Form != nil {
+		section, err := s.Form.Compile()
+		add("/form", section, err)
+	}
+	if s.Multipart != nil {
+		section, err := s.Multipart.Compile()
+		add("/multipart", section, err)
+	}
+	if s.XML != nil {
+		section, err := s.XML.Compile()
+		add("/xml", section, err)
+	}
+	if s.Content != nil {
+		section, err := s.Content.compile()
+		add("/content", section, err)
+	}
+	if err := rerror.LintFromIssues("request schema compile", issues); err != nil {
+		return nil, err
+	}
+	return compiled, nil
+}
+
+// Schema returns the schema that was compiled.
+func (c *CompiledSchema) Schema() Schema {
+	return c.schema
+}
+
+// Validate validates the request the same as Schema.Validate.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	errs := make([]error, len(c.sections))
+	for i, section := range c.sections {
+		errs[i] = section.Validate(req)
+	}
+	return validationErr(errs)
+}
+
+type compiledContent struct {
+	content Content
+	bodies  map[string]compiledSection
+}
+
+func (c Content) compile() (*compiledContent, error) {
+	compiled := &compiledContent{
+		content: c,
+		bodies:  make(map[string]compiledSection, len(c.MediaTypes)),
+	}
+	issues := []rerror.LintIssue{}
+	for _, mediaType := range c.supported() {
+		path := "/media_types/" + rerror.PointerToken(mediaType)
+		cb := c.MediaTypes[mediaType]
+		var (
+			section compiledSection
+			err     error
+		)
+		switch {
+		case cb.Body != nil:
+			path += "/body"
+			section, err = cb.Body.Compile()
+		case cb.Form != nil:
+			path += "/form"
+			section, err = cb.Form.Compile()
+		case cb.Multipart != nil:
+			path += "/multipart"
+			section, err = cb.Multipart.Compile()
+		case cb.XML != nil:
+			path += "/xml"
+			section, err = cb.XML.Compile()
+		default:
+			err = errors.New("content body requires a body, form, multipart or xml schema")
+		}
+		var lintErr *rerror.LintErr
+		switch {
+		case errors.As(err, &lintErr):
+			for _, issue := range lintErr.Issues {
+				issues = append(issues, rerror.LintIssue{
+					Path: path + issue.Path,
+					Msg:  issue.Msg,
+				})
+			}
+		case err != nil:
+			issues = append(issues, rerror.LintIssue{
+				Path: path,
+				Msg:  err.Error(),
+			})
+		default:
+			compiled.bodies[mediaType] = section
+		}
+	}
+	if err := rerror.LintFromIssues("content schema compile", issues); err != nil {
+		return nil, err
+	}
+	return compiled, nil
+}
+
+func (c *compiledContent) Validate(req *http.Request) error {
+	key, err := c.content.mediaType(req)
+	if err != nil {
+		return err
+	}
+	return c.bodies[key].Validate(req)
+}
@@ -0,0 +1,139 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestSchema_Compile(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: `{
+					"endpoint": {
+						"method": "POST",
+						"endpoint": "/users/{id}",
+						"path_variables": {"{id}": {"validation": {"string_validator": {"regex": "^[0-9]+$"}}}}
+					},
+					"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}
+				}`,
+			},
+			want: nil,
+		},
+		{
+			name: "fail: every section",
+			args: args{
+				schema: `{
+					"endpoint": {
+						"method": "POST",
+						"endpoint": "/users/{id}",
+						"path_variables": {"{id}": {"validation": {"string_validator": {"regex": "[0-9"}}}}
+					},
+					"query": {"parameters": {"q": {"validation": {"string_validator": {"regex": "[a-z"}}}}},
+					"header": {"parameters": {"X-Since": {"validation": {"time_validator": {"format": "2006-01-02", "before": "tomorrow"}}}}},
+					"cookie": {"parameters": {"session": {"validation": {"string_validator": {"regex": "(a"}}}}},
+					"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {"regex": "[a-z"}}}}}}}
+				}`,
+			},
+			want: []string{
+				"/endpoint/path_variables/{id}/validation",
+				"/query/parameters/q/validation",
+				"/header/parameters/X-Since/validation",
+				"/cookie/parameters/session/validation",
+				"/body/body/object/parameters/name/validation",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema Schema
+			if err := json.NewDecoder(strings.NewReader(tt.args.schema)).Decode(&schema); err != nil {
+				t.Fatalf("schema decode error = %v", err)
+			}
+			compiled, err := schema.Compile()
+			if tt.want == nil {
+				if err != nil || compiled == nil {
+					t.Errorf("Schema.Compile() error = %v", err)
+				}
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("Schema.Compile() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Schema.Compile() issues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompiledSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(`{
+		"endpoint": {
+			"method": "POST",
+			"endpoint": "/users/{id}",
+			"path_variables": {"{id}": {"validation": {"string_validator": {"regex": "^[0-9]+$"}}}}
+		},
+		"query": {"parameters": {"mode": {"validation": {"string_validator": {"one_of": ["strict", "lenient"]}}}}},
+		"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {"regex": "^[a-z]+$"}}}}}}}
+	}`))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		path    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			path:    "/users/10?mode=strict",
+			body:    `{"name": "gary"}`,
+			wantErr: false,
+		},
+		{
+			name:    "fail: sections",
+			path:    "/users/10?mode=other",
+			body:    `{"name": "Gary"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com"+tt.path, strings.NewReader(tt.body))
+			err := compiled.Validate(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompiledSchema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			req = httptest.NewRequest(http.MethodPost, "http://www.test.com"+tt.path, strings.NewReader(tt.body))
+			if want := schema.Validate(req); !reflect.DeepEqual(err, want) {
+				t.Errorf("CompiledSchema.Validate() error = %v, want %v", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/form"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/mbody"
+	"github.com/g8rswimmer/httpx/request/rerror"
+	"github.com/g8rswimmer/httpx/request/xbody"
+)
+
+// Content selects the body schema with the media type of the request
+// Content-Type, the parameters such as charset are not part of the media type.
+// A media type can be a type wildcard, text/*, which is used when there is not
+// an exact match.  The default media type is used when the request does not
+// have a Content-Type, the request Content-Type is set to it so a form body is
+// parsed.  A multipart body can not be the default, the boundary is a
+// parameter of the request Content-Type.
+type Content struct {
+	Default    string                 `json:"default"`
+	MediaTypes map[string]ContentBody `json:"media_types"`
+}
+
+// ContentBody is the body schema of a media type, only one is allowed.
+type ContentBody struct {
+	Body      *jbody.Schema `json:"body"`
+	Form      *form.Schema  `json:"form"`
+	Multipart *mbody.Schema `json:"multipart"`
+	XML       *xbody.Schema `json:"xml"`
+}
+
+// Validate validates the request body with the schema of the Content-Type
+// media type.  A media type error is returned when the media type is not
+// supported.
+func (c Content) Validate(req *http.Request) error {
+	cb, err := c.Select(req)
+	if err != nil {
+		return err
+	}
+	return cb.Validate(req)
+}
+
+// Select returns the body schema of the request Content-Type media type.
+func (c Content) Select(req *http.Request) (ContentBody, error) {
+	key, err := c.mediaType(req)
+	if err != nil {
+		return ContentBody{}, err
+	}
+	return c.MediaTypes[key], nil
+}
+
+// mediaType returns the media types key of the request Content-Type.
+func (c Content) mediaType(req *http.Request) (string, error) {
+	ct := req.Header.Get("Content-Type")
+	mediaType := strings.ToLower(c.Default)
+	if len(ct) > 0 {
+		mt, _, err := mime.ParseMediaType(ct)
+		if err != nil {
+			return "", c.mediaTypeErr(ct, fmt.Sprintf("request content type [%s] is not valid", ct))
+		}
+		mediaType = mt
+	}
+	if len(mediaType) == 0 {
+		return "", c.mediaTypeErr(ct, "request content type is required")
+	}
+	if key, has := c.lookup(mediaType); has {
+		if len(ct) == 0 {
+			req.Header.Set("Content-Type", c.Default)
+		}
+		return key, nil
+	}
+	return "", c.mediaTypeErr(ct, fmt.Sprintf("request media type [%s] is not supported", mediaType))
+}
+
+// lookup returns the media types key of the media type, an exact match is
+// preferred over a type wildcard.
+func (c Content) lookup(mediaType string) (string, bool) {
+	wildcard := ""
+	if i := strings.Index(mediaType, "/"); i > 0 {
+		wildcard = mediaType[:i] + "/*"
+	}
+	match := ""
+	for key := range c.MediaTypes {
+		switch strings.ToLower(key) {
+		case mediaType:
+			return key, true
+		case wildcard:
+			match = key
+		}
+	}
+	return match, len(match) > 0
+}
+
+func (c Content) mediaTypeErr(contentType, msg string) error {
+	return &rerror.MediaTypeErr{
+		Msg:         msg,
+		StatusCode:  http.StatusUnsupportedMediaType,
+		ContentType: contentType,
+		Supported:   c.supported(),
+	}
+}
+
+func (c Content) supported() []string {
+	supported := make([]string, 0, len(c.MediaTypes))
+	for mediaType := range c.MediaTypes {
+		supported = append(supported, mediaType)
+	}
+	sort.Strings(supported)
+	return supported
+}
+
+func (cb ContentBody) Validate(req *http.Request) error {
+	switch {
+	case cb.Body != nil:
+		return cb.Body.Validate(req)
+	case cb.Form != nil:
+		return cb.Form.Validate(req)
+	case cb.Multipart != nil:
+		return cb.Multipart.Validate(req)
+	case cb.XML != nil:
+		return cb.XML.Validate(req)
+	default:
+		return errors.New("content body requires a body, form, multipart or xml schema")
+	}
+}
+
+func SchemaModelContentValidator(content Content) error {
+	if len(content.MediaTypes) == 0 {
+		return errors.New("content media types are required")
+	}
+	mediaTypes := map[string]struct{}{}
+	for _, mediaType := range content.supported() {
+		mt, params, err := mime.ParseMediaType(mediaType)
+		switch {
+		case err != nil || !strings.Contains(mt, "/"):
+			return fmt.Errorf("content media type [%s] is not valid", mediaType)
+		case len(params) > 0:
+			return fmt.Errorf("content media type [%s] can not have parameters", mediaType)
+		}
+		if _, has := mediaTypes[mt]; has {
+			return fmt.Errorf("content media type [%s] is a duplicate", mediaType)
+		}
+		mediaTypes[mt] = struct{}{}
+		if err := schemaModelContentBodyValidator(content.MediaTypes[mediaType]); err != nil {
+			return fmt.Errorf("content media type [%s]: %w", mediaType, err)
+		}
+	}
+	if len(content.Default) > 0 {
+		key, has := content.lookup(strings.ToLower(content.Default))
+		switch {
+		case !has:
+			return fmt.Errorf("content default [%s] is not a media type", content.Default)
+		case content.MediaTypes[key].Multipart != nil:
+			return fmt.Errorf("content default [%s] can not be multipart, the boundary is a content type parameter", content.Default)
+		}
+	}
+	return nil
+}
+
+func schemaModelContentBodyValidator(cb ContentBody) error {
+	count := 0
+	if cb.Body != nil {
+		count++
+		if err := jbody.Lint(*cb.Body); err != nil {
+			return fmt.Errorf("schema body: %w", err)
+		}
+	}
+	if cb.Form != nil {
+		count++
+		if err := form.SchemaModelValidator(*cb.Form); err != nil {
+			return fmt.Errorf("schema form: %w", err)
+		}
+	}
+	if cb.Multipart != nil {
+		count++
+		if err := mbody.SchemaModelValidator(*cb.Multipart); err != nil {
+			return fmt.Errorf("schema multipart: %w", err)
+		}
+	}
+	if cb.XML != nil {
+		count++
+		if err := xbody.SchemaModelValidator(*cb.XML); err != nil {
+			return fmt.Errorf("schema xml: %w", err)
+		}
+	}
+	if count != 1 {
+		return errors.New("content body requires one of body, form, multipart or xml")
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testContentJSON = `{
+	"default": "application/json",
+	"media_types": {
+		"application/json": {
+			"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {"regex": "^[a-z]+$"}}}}}}}
+		},
+		"application/x-www-form-urlencoded": {
+			"form": {"parameters": {"name": {"validation": {"string_validator": {"regex": "^[a-z]+$"}}}}}
+		},
+		"text/*": {
+			"xml": {"root": "user", "body": {"object": {"parameters": {"name": {"validation": {"string_validator": {"regex": "^[a-z]+$"}}}}}}}
+		}
+	}
+}`
+
+func TestContent_Validate(t *testing.T) {
+	var content Content
+	if err := json.Unmarshal([]byte(testContentJSON), &content); err != nil {
+		t.Fatalf("content decode error = %v", err)
+	}
+	if err := SchemaModelContentValidator(content); err != nil {
+		t.Fatalf("SchemaModelContentValidator() error = %v", err)
+	}
+	compiled, err := Schema{Content: &content}.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	type args struct {
+		contentType string
+		body        string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantErr       bool
+		wantMediaType bool
+	}{
+		{
+			name: "success: json",
+			args: args{
+				contentType: "application/json",
+				body:        `{"name": "gary"}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: json charset",
+			args: args{
+				contentType: "Application/JSON; charset=utf-8",
+				body:        `{"name": "gary"}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: default",
+			args: args{
+				body: `{"name": "gary"}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: form",
+			args: args{
+				contentType: "application/x-www-form-urlencoded; charset=utf-8",
+				body:        "name=gary",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: wildcard",
+			args: args{
+				contentType: "text/xml",
+				body:        "<user><name>gary</name></user>",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: form value",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "name=Gary",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: unsupported",
+			args: args{
+				contentType: "application/octet-stream",
+				body:        "gary",
+			},
+			wantErr:       true,
+			wantMediaType: true,
+		},
+		{
+			name: "fail: invalid",
+			args: args{
+				contentType: "application/json; charset",
+				body:        `{"name": "gary"}`,
+			},
+			wantErr:       true,
+			wantMediaType: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []interface{ Validate(*http.Request) error }{content, Schema{Content: &content}, compiled} {
+				req := httptest.NewRequest(http.MethodPost, "http://www.test.com/users", strings.NewReader(tt.args.body))
+				if len(tt.args.contentType) > 0 {
+					req.Header.Set("Content-Type", tt.args.contentType)
+				}
+				err := v.Validate(req)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				var mediaTypeErr *rerror.MediaTypeErr
+				if errors.As(err, &mediaTypeErr) != tt.wantMediaType {
+					t.Fatalf("Validate() error = %v, want media type error %v", err, tt.wantMediaType)
+				}
+				if tt.wantMediaType {
+					want := []string{"application/json", "application/x-www-form-urlencoded", "text/*"}
+					if mediaTypeErr.StatusCode != http.StatusUnsupportedMediaType || !reflect.DeepEqual(mediaTypeErr.Supported, want) {
+						t.Errorf("Validate() media type error = %+v", mediaTypeErr)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestContent_Validate_NoDefault(t *testing.T) {
+	content := Content{
+		MediaTypes: map[string]ContentBody{
+			"application/json": {},
+		},
+	}
+	req := httptest.NewRequest(http.MethodPost, "http://www.test.com/users", strings.NewReader(`{}`))
+	var mediaTypeErr *rerror.MediaTypeErr
+	if err := content.Validate(req); !errors.As(err, &mediaTypeErr) {
+		t.Errorf("Content.Validate() error = %v, want a media type error", err)
+	}
+}
+
+func TestContent_Validate_DefaultForm(t *testing.T) {
+	var content Content
+	if err := json.Unmarshal([]byte(testContentJSON), &content); err != nil {
+		t.Fatalf("content decode error = %v", err)
+	}
+	content.Default = "application/x-www-form-urlencoded"
+	if err := SchemaModelContentValidator(content); err != nil {
+		t.Fatalf("SchemaModelContentValidator() error = %v", err)
+	}
+	compiled, err := Schema{Content: &content}.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	type args struct {
+		body string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				body: "name=gary",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: form value",
+			args: args{
+				body: "name=Gary",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []interface{ Validate(*http.Request) error }{content, Schema{Content: &content}, compiled} {
+				req := httptest.NewRequest(http.MethodPost, "http://www.test.com/users", strings.NewReader(tt.args.body))
+				err := v.Validate(req)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got := req.FormValue("name"); err == nil && got != "gary" {
+					t.Errorf("FormValue() = %s, want gary", got)
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaModelContentValidator(t *testing.T) {
+	body := `{"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}}`
+	type args struct {
+		content string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				content: testContentJSON,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: no media types",
+			args: args{
+				content: `{"default": "application/json"}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: media type",
+			args: args{
+				content: `{"media_types": {"json": ` + body + `}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: media type parameters",
+			args: args{
+				content: `{"media_types": {"application/json; charset=utf-8": ` + body + `}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: duplicate",
+			args: args{
+				content: `{"media_types": {"application/json": ` + body + `, "Application/JSON": ` + body + `}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: default",
+			args: args{
+				content: `{"default": "application/xml", "media_types": {"application/json": ` + body + `}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: default multipart",
+			args: args{
+				content: `{"default": "multipart/form-data", "media_types": {"multipart/form-data": {"multipart": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: no body",
+			args: args{
+				content: `{"media_types": {"application/json": {}}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: body",
+			args: args{
+				content: `{"media_types": {"application/json": {"body": {"body": {}}, "form": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}}`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var content Content
+			if err := json.Unmarshal([]byte(tt.args.content), &content); err != nil {
+				t.Fatalf("content decode error = %v", err)
+			}
+			if err := SchemaModelContentValidator(content); (err != nil) != tt.wantErr {
+				t.Errorf("SchemaModelContentValidator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
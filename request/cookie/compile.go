@@ -0,0 +1,38 @@
+package cookie
+
+import (
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	compiled.RequiredFields = s.RequiredFields.Clone()
+	parameters, issues := parameter.CompileParameters(s.Parameters)
+	compiled.Parameters = parameters
+	if err := rerror.LintFromIssues("cookie schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate validates the request cookies.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
+
+func (p ParameterProperties) Compile() (ParameterProperties, error) {
+	validation, err := p.Validation.Compile()
+	p.Validation = validation
+	if p.MaxLength != nil {
+		maxLength := *p.MaxLength
+		p.MaxLength = &maxLength
+	}
+	return p, err
+}
@@ -0,0 +1,54 @@
+package cookie
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+)
+
+type ParameterValidation = parameter.Values
+
+type ParameterProperties struct {
+	Description          string              `json:"description"`
+	Example              string              `json:"example"`
+	MaxLength            *int                `json:"max_length"`
+	InlineArray          bool                `json:"inline_array"`
+	InlineArraySeperator string              `json:"inline_array_seperator"`
+	Validation           ParameterValidation `json:"validation"`
+}
+
+func (p ParameterProperties) Validate(value string) error {
+	if p.MaxLength != nil && len(value) > *p.MaxLength {
+		return fmt.Errorf("cookie validation: value length [%d] is greater than %d", len(value), *p.MaxLength)
+	}
+	switch {
+	case len(value) == 0:
+		return nil
+	case p.InlineArray:
+		values := strings.Split(value, p.InlineArraySeperator)
+		if err := p.Validation.ValidateValues("cookie", values); err != nil {
+			return fmt.Errorf("cookie validation: %w", err)
+		}
+	default:
+		if err := p.Validation.ValidateValue("cookie", value); err != nil {
+			return fmt.Errorf("cookie validation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func SchemaModelParameterPropertiesValidator(properties ParameterProperties) error {
+	if err := properties.Validation.Validator(); err != nil {
+		return fmt.Errorf("propoerties data type error: %w", err)
+	}
+	if properties.InlineArray && len(properties.InlineArraySeperator) == 0 {
+		return errors.New("properties inline array requires a seperator")
+	}
+	if properties.MaxLength != nil && *properties.MaxLength <= 0 {
+		return errors.New("properties max length must be greater than zero")
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package cookie
+
+import (
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+)
+
+func TestSchemaModelParameterPropertiesValidator(t *testing.T) {
+	type args struct {
+		properties ParameterProperties
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				properties: ParameterProperties{
+					Description: "None",
+					Example:     "Test",
+					MaxLength: func() *int {
+						l := 10
+						return &l
+					}(),
+					Validation: ParameterValidation{
+						String: &parameter.StringValidator{},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: bad data type",
+			args: args{
+				properties: ParameterProperties{
+					Description: "None",
+					Example:     "Test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: max length",
+			args: args{
+				properties: ParameterProperties{
+					Description: "None",
+					Example:     "Test",
+					MaxLength: func() *int {
+						l := 0
+						return &l
+					}(),
+					Validation: ParameterValidation{
+						String: &parameter.StringValidator{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SchemaModelParameterPropertiesValidator(tt.args.properties); (err != nil) != tt.wantErr {
+				t.Errorf("SchemaModelParameterPropertiesValidator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParameterProperties_Validate(t *testing.T) {
+	type fields struct {
+		MaxLength            *int
+		InlineArray          bool
+		InlineArraySeperator string
+		Validation           ParameterValidation
+	}
+	type args struct {
+		value string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				MaxLength: func() *int {
+					l := 5
+					return &l
+				}(),
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{},
+				},
+			},
+			args: args{
+				value: "en-US",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: inline array",
+			fields: fields{
+				InlineArray:          true,
+				InlineArraySeperator: "|",
+				Validation: ParameterValidation{
+					NumberArray: &parameter.NumberArrayValidator{},
+				},
+			},
+			args: args{
+				value: "1|2|3",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: max length",
+			fields: fields{
+				MaxLength: func() *int {
+					l := 4
+					return &l
+				}(),
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{},
+				},
+			},
+			args: args{
+				value: "en-US",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: value",
+			fields: fields{
+				Validation: ParameterValidation{
+					Boolean: &parameter.BooleanValidator{},
+				},
+			},
+			args: args{
+				value: "yes",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := ParameterProperties{
+				MaxLength:            tt.fields.MaxLength,
+				InlineArray:          tt.fields.InlineArray,
+				InlineArraySeperator: tt.fields.InlineArraySeperator,
+				Validation:           tt.fields.Validation,
+			}
+			if err := p.Validate(tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("ParameterProperties.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package cookie
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type Schema struct {
+	Title          string                         `json:"title"`
+	Description    string                         `json:"description"`
+	RequiredFields field.Required                 `json:"required_fields"`
+	Parameters     map[string]ParameterProperties `json:"parameters"`
+}
+
+func (s Schema) Validate(req *http.Request) error {
+	parameterErr := &rerror.ParameterErr{
+		Parameters: map[string]string{},
+	}
+
+	cookies := map[string]string{}
+	for _, cookie := range req.Cookies() {
+		if _, has := cookies[cookie.Name]; has {
+			if _, has := s.Parameters[cookie.Name]; has {
+				parameterErr.Add(cookie.Name, "cookie validation: multiple values present")
+			}
+			continue
+		}
+		cookies[cookie.Name] = cookie.Value
+	}
+
+	if err := s.RequiredFields.Validate(field.Set(cookies)); err != nil {
+		return rerror.SchemaFromError("request cookie validation", err)
+	}
+
+	for name, properties := range s.Parameters {
+		if _, has := parameterErr.Parameters[name]; has {
+			continue
+		}
+		if err := properties.Validate(cookies[name]); err != nil {
+			parameterErr.Add(name, err.Error())
+		}
+	}
+	return rerror.SchemaFromError("request cookie validation", parameterErr)
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case len(schema.Parameters) == 0:
+		return errors.New("schema parameters is required")
+	default:
+	}
+	parameters := map[string]struct{}{}
+	for param, properties := range schema.Parameters {
+		if err := SchemaModelParameterPropertiesValidator(properties); err != nil {
+			return fmt.Errorf("schema parameter [%s]: %w", param, err)
+		}
+		parameters[param] = struct{}{}
+	}
+	if err := schema.RequiredFields.Validate(parameters); err != nil {
+		return fmt.Errorf("schema required parameters missing: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package cookie
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestSchemaModelValidator(t *testing.T) {
+	type args struct {
+		schema Schema
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+					RequiredFields: field.Required{
+						OneOf: [][]string{{"session_id"}},
+					},
+					Parameters: map[string]ParameterProperties{
+						"session_id": {
+							Validation: ParameterValidation{
+								String: &parameter.StringValidator{},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: no parameters",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: required not a parameter",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+					RequiredFields: field.Required{
+						OneOf: [][]string{{"csrf_token"}},
+					},
+					Parameters: map[string]ParameterProperties{
+						"session_id": {
+							Validation: ParameterValidation{
+								String: &parameter.StringValidator{},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SchemaModelValidator(tt.args.schema); (err != nil) != tt.wantErr {
+				t.Errorf("SchemaModelValidator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := Schema{
+		Title: "Cookie Test",
+		RequiredFields: field.Required{
+			OneOf: [][]string{{"session_id", "csrf_token"}},
+		},
+		Parameters: map[string]ParameterProperties{
+			"session_id": {
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{
+						RegEx: func() *string {
+							s := parameter.RegExUUIDv4
+							return &s
+						}(),
+					},
+				},
+			},
+			"csrf_token": {
+				MaxLength: func() *int {
+					l := 8
+					return &l
+				}(),
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{},
+				},
+			},
+			"locale": {
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{
+						OneOf: []string{"en-US", "fr-FR"},
+					},
+				},
+			},
+		},
+	}
+	type args struct {
+		cookies []*http.Cookie
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantErr        bool
+		wantParameters []string
+	}{
+		{
+			name: "success",
+			args: args{
+				cookies: []*http.Cookie{
+					{Name: "session_id", Value: "8b4a60d8-c203-460f-92eb-82646c93d792"},
+					{Name: "csrf_token", Value: "abcd1234"},
+					{Name: "locale", Value: "fr-FR"},
+					{Name: "_ga", Value: "tracking"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: required",
+			args: args{
+				cookies: []*http.Cookie{
+					{Name: "session_id", Value: "8b4a60d8-c203-460f-92eb-82646c93d792"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: parameters",
+			args: args{
+				cookies: []*http.Cookie{
+					{Name: "session_id", Value: "8b4a60d8-c203-460f-92eb-82646c93d792"},
+					{Name: "csrf_token", Value: "abcd12345"},
+					{Name: "locale", Value: "de-DE"},
+				},
+			},
+			wantErr:        true,
+			wantParameters: []string{"csrf_token", "locale"},
+		},
+		{
+			name: "fail: multiple values",
+			args: args{
+				cookies: []*http.Cookie{
+					{Name: "session_id", Value: "8b4a60d8-c203-460f-92eb-82646c93d792"},
+					{Name: "session_id", Value: "8b4a60d8-c203-460f-92eb-82646c93d793"},
+					{Name: "csrf_token", Value: "abcd1234"},
+				},
+			},
+			wantErr:        true,
+			wantParameters: []string{"session_id"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://www.cookie-example.com", nil)
+			for _, cookie := range tt.args.cookies {
+				req.AddCookie(cookie)
+			}
+			err := schema.Validate(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(tt.wantParameters) == 0 {
+				return
+			}
+			var schemaErr *rerror.SchemaErr
+			if !errors.As(err, &schemaErr) || schemaErr.Parameter == nil {
+				t.Errorf("Schema.Validate() error = %v, want parameter error", err)
+				return
+			}
+			for _, name := range tt.wantParameters {
+				if _, has := schemaErr.Parameter.Parameters[name]; !has {
+					t.Errorf("Schema.Validate() parameter [%s] error not present", name)
+				}
+			}
+		})
+	}
+}
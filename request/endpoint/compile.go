@@ -0,0 +1,59 @@
+package endpoint
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	compiled.PathVariables = make(map[string]PathVariable, len(s.PathVariables))
+
+	variables := make([]string, 0, len(s.PathVariables))
+	for variable := range s.PathVariables {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+
+	issues := []rerror.LintIssue{}
+	for _, variable := range variables {
+		pv := s.PathVariables[variable]
+		validation, err := pv.Validation.compile()
+		if err != nil {
+			issues = append(issues, rerror.LintIssue{
+				Path: "/path_variables/" + rerror.PointerToken(variable) + "/validation",
+				Msg:  err.Error(),
+			})
+			continue
+		}
+		pv.Validation = validation
+		compiled.PathVariables[variable] = pv
+	}
+	if err := rerror.LintFromIssues("endpoint schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate validates the request method and path.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
+
+func (v VariableValidation) compile() (VariableValidation, error) {
+	var err error
+	if v.String, err = parameter.Compile(v.String); err != nil {
+		return VariableValidation{}, err
+	}
+	if v.Number, err = parameter.Compile(v.Number); err != nil {
+		return VariableValidation{}, err
+	}
+	return v, nil
+}
@@ -0,0 +1,43 @@
+package endpoint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Lint returns an error with all of the schema rules that contradict each
+// other, or can never be satisfied.
+func Lint(schema Schema) error {
+	issues := []rerror.LintIssue{}
+	segments := map[string]struct{}{}
+	for _, segment := range strings.Split(schema.Endpoint, "/") {
+		segments[segment] = struct{}{}
+	}
+
+	variables := make([]string, 0, len(schema.PathVariables))
+	for variable := range schema.PathVariables {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	for _, variable := range variables {
+		path := "/path_variables/" + rerror.PointerToken(variable)
+		if _, has := segments[variable]; !has {
+			issues = append(issues, rerror.LintIssue{
+				Path: path,
+				Msg:  fmt.Sprintf("path variable is not an endpoint [%s] segment", schema.Endpoint),
+			})
+		}
+		v := schema.PathVariables[variable].Validation
+		path += "/validation"
+		if v.String != nil {
+			issues = append(issues, v.String.Lint(path+"/string_validator")...)
+		}
+		if v.Number != nil {
+			issues = append(issues, v.Number.Lint(path+"/number_validator")...)
+		}
+	}
+	return rerror.LintFromIssues("endpoint schema lint", issues)
+}
@@ -0,0 +1,75 @@
+package endpoint
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestSchemaFromJSON_Lint(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: `{
+					"method": "GET",
+					"endpoint": "/users/{id}",
+					"path_variables": {
+						"{id}": {"validation": {"number_validator": {"min": 1}}}
+					}
+				}`,
+			},
+			want: nil,
+		},
+		{
+			name: "fail: contradictions",
+			args: args{
+				schema: `{
+					"method": "GET",
+					"endpoint": "/users/{id}",
+					"path_variables": {
+						"{id}": {"validation": {"number_validator": {"min": 1, "one_of": [0, 1]}}},
+						"{name}": {"validation": {"string_validator": {"regex": "(["}}}
+					}
+				}`,
+			},
+			want: []string{
+				"/path_variables/{id}/validation/number_validator/one_of/0",
+				"/path_variables/{name}",
+				"/path_variables/{name}/validation/string_validator/regex",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromJSON(strings.NewReader(tt.args.schema))
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("SchemaFromJSON() error = %v", err)
+				}
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("SchemaFromJSON() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SchemaFromJSON() lint paths = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
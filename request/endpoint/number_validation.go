@@ -18,3 +18,9 @@ func (p NumberValidator) Validate(value string) error {
 	}
 	return p.NumberValidator.Validate(num)
 }
+
+// Compile returns a copy of the validator with the one of values in a set.
+func (p NumberValidator) Compile() (NumberValidator, error) {
+	v, err := p.NumberValidator.Compile()
+	return NumberValidator{NumberValidator: v}, err
+}
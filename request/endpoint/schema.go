@@ -1,13 +1,14 @@
 package endpoint
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"strings"
 
+	"github.com/g8rswimmer/httpx/internal/schemaref"
 	"github.com/g8rswimmer/httpx/request/rerror"
 )
 
@@ -50,7 +51,7 @@ func (s Schema) Validate(req *http.Request) error {
 
 func SchemaFromJSON(reader io.Reader) (Schema, error) {
 	var schema Schema
-	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+	if err := schemaref.Decode(reader, &schema); err != nil {
 		return Schema{}, fmt.Errorf("schema decode json: %w", err)
 	}
 	if err := SchemaModelValidator(schema); err != nil {
@@ -59,6 +60,34 @@ func SchemaFromJSON(reader io.Reader) (Schema, error) {
 	return schema, nil
 }
 
+// SchemaFromYAML decodes the schema from YAML, with the same field names as
+// the JSON schema, and the errors have the line and column of the value.
+func SchemaFromYAML(reader io.Reader) (Schema, error) {
+	var schema Schema
+	doc, err := schemaref.DecodeYAML(reader, &schema)
+	if err != nil {
+		return Schema{}, fmt.Errorf("schema decode yaml: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", doc.Locate(err))
+	}
+	return schema, nil
+}
+
+// SchemaFromFS decodes the schema file of the file system, the references to
+// other files are relative to the file.  A file with a .yaml or .yml
+// extension is YAML and any other file is JSON.
+func SchemaFromFS(fsys fs.FS, name string) (Schema, error) {
+	var schema Schema
+	if err := schemaref.DecodeFS(fsys, name, &schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode file: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
 func SchemaModelValidator(schema Schema) error {
 	switch {
 	case len(schema.Method) == 0:
@@ -70,5 +99,8 @@ func SchemaModelValidator(schema Schema) error {
 			return fmt.Errorf("schema parameter [%s]: %w", param, err)
 		}
 	}
+	if err := Lint(schema); err != nil {
+		return err
+	}
 	return nil
 }
@@ -3,6 +3,8 @@ package endpoint
 import (
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/g8rswimmer/httpx/request/internal/parameter"
@@ -232,3 +234,94 @@ func TestSchemaModelValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaFromYAML(t *testing.T) {
+	type args struct {
+		yaml string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				yaml: `
+title: Endpoint Example
+method: GET
+endpoint: /schema/example/{id}
+path_variables:
+  "{id}":
+    validation:
+      string_validator:
+        regex: "^[a-f0-9]{8}$"
+`,
+			},
+			want: `{
+				"title": "Endpoint Example",
+				"method": "GET",
+				"endpoint": "/schema/example/{id}",
+				"path_variables": {
+					"{id}": {"validation": {"string_validator": {"regex": "^[a-f0-9]{8}$"}}}
+				}
+			}`,
+		},
+		{
+			name: "fail: decode",
+			args: args{
+				yaml: `
+method: GET
+endpoint:
+  - /schema/example
+`,
+			},
+			wantErr: "line 4, column 3",
+		},
+		{
+			name: "fail: lint",
+			args: args{
+				yaml: `
+method: GET
+endpoint: /schema/example/{id}
+path_variables:
+  "{name}":
+    validation:
+      string_validator: {}
+`,
+			},
+			wantErr: "line 5, column 3",
+		},
+		{
+			name: "fail: model",
+			args: args{
+				yaml: `
+endpoint: /schema/example
+`,
+			},
+			wantErr: "schema endpoint method is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SchemaFromYAML(strings.NewReader(tt.args.yaml))
+			if len(tt.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("SchemaFromYAML() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SchemaFromYAML() error = %v", err)
+			}
+			want, err := SchemaFromJSON(strings.NewReader(tt.want))
+			if err != nil {
+				t.Fatalf("SchemaFromJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("SchemaFromYAML() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -9,3 +9,10 @@ type StringValidator struct {
 func (p StringValidator) Validate(value string) error {
 	return p.StringValidator.Validate(value)
 }
+
+// Compile returns a copy of the validator with the reg exp compiled and the
+// one of values in a set.
+func (p StringValidator) Compile() (StringValidator, error) {
+	v, err := p.StringValidator.Compile()
+	return StringValidator{StringValidator: v}, err
+}
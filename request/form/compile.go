@@ -0,0 +1,28 @@
+package form
+
+import (
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	compiled.RequiredFields = s.RequiredFields.Clone()
+	parameters, issues := parameter.CompileParameters(s.Parameters)
+	compiled.Parameters = parameters
+	if err := rerror.LintFromIssues("form schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate validates the request form body.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
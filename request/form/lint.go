@@ -0,0 +1,34 @@
+package form
+
+import (
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Lint returns an error with all of the schema rules that contradict each
+// other, or can never be satisfied.  Unlike the query, an array validator does
+// not require an inline array since the values can be repeated keys.
+func Lint(schema Schema) error {
+	issues := schema.RequiredFields.Lint("/required_fields", field.Set(schema.Parameters))
+
+	params := make([]string, 0, len(schema.Parameters))
+	for param := range schema.Parameters {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+	for _, param := range params {
+		properties := schema.Parameters[param]
+		path := "/parameters/" + rerror.PointerToken(param)
+		v := properties.Validation
+		if properties.InlineArray && (v.String != nil || v.Number != nil || v.Time != nil || v.Boolean != nil) {
+			issues = append(issues, rerror.LintIssue{
+				Path: path + "/inline_array",
+				Msg:  "inline array requires an array validator",
+			})
+		}
+		issues = append(issues, v.Lint(path+"/validation")...)
+	}
+	return rerror.LintFromIssues("form schema lint", issues)
+}
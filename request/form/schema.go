@@ -0,0 +1,117 @@
+package form
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/query"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const contentType = "application/x-www-form-urlencoded"
+
+// Schema validates an application/x-www-form-urlencoded request body with the
+// query schema model.  A parameter can be an inline array, repeated keys or
+// both when the parameter has an array validator.
+type Schema struct {
+	Title          string                               `json:"title"`
+	Description    string                               `json:"description"`
+	RequiredFields field.Required                       `json:"required_fields"`
+	Parameters     map[string]query.ParameterProperties `json:"parameters"`
+}
+
+// Validate parses and validates the request form body.  The request PostForm
+// is populated and the body is replaced, so handlers can still use FormValue
+// or read the body.
+func (s Schema) Validate(req *http.Request) error {
+	values, err := postForm(req)
+	if err != nil {
+		return rerror.SchemaFromError("request form validation", err)
+	}
+
+	if err := field.Validate(values, s.Parameters); err != nil {
+		return rerror.SchemaFromError("request form validation", err)
+	}
+
+	if err := s.RequiredFields.Validate(field.Set(values)); err != nil {
+		return rerror.SchemaFromError("request form validation", err)
+	}
+
+	parameterErr := &rerror.ParameterErr{
+		Parameters: map[string]string{},
+	}
+	for key, properties := range s.Parameters {
+		if err := properties.ValidateValues(values[key]); err != nil {
+			parameterErr.Add(key, fmt.Sprintf("form validation: %v", err))
+		}
+	}
+	return rerror.SchemaFromError("request form validation", parameterErr)
+}
+
+// postForm returns the request form body values.  The body is read and
+// replaced before it is parsed so it is still available to the handler.
+func postForm(req *http.Request) (url.Values, error) {
+	if req.PostForm != nil {
+		return req.PostForm, nil
+	}
+	ct := req.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(ct); err != nil || mediaType != contentType {
+		return nil, fmt.Errorf("request content type [%s] is not %s", ct, contentType)
+	}
+	var raw []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("schema form read: %w", err)
+		}
+		raw = b
+	}
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+	err := req.ParseForm()
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("schema form parse: %w", err)
+	}
+	return req.PostForm, nil
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case len(schema.Parameters) == 0:
+		return errors.New("schema parameters is required")
+	default:
+	}
+	parameters := map[string]struct{}{}
+	for param, properties := range schema.Parameters {
+		if err := query.SchemaModelParameterPropertiesValidator(properties); err != nil {
+			return fmt.Errorf("schema parameter [%s]: %w", param, err)
+		}
+		parameters[param] = struct{}{}
+	}
+	if err := schema.RequiredFields.Validate(parameters); err != nil {
+		return fmt.Errorf("schema required parameters missing: %w", err)
+	}
+	if err := Lint(schema); err != nil {
+		return err
+	}
+	return nil
+}
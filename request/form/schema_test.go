@@ -0,0 +1,148 @@
+package form
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testSchemaJSON = `{
+	"required_fields": {"one_of": [["grant_type"]], "present": {"refresh_token": ["client_id"]}},
+	"parameters": {
+		"grant_type": {"validation": {"string_validator": {"one_of": ["client_credentials", "refresh_token"]}}},
+		"client_id": {"validation": {"string_validator": {"regex": "^[a-z0-9]+$"}}},
+		"refresh_token": {"validation": {"string_validator": {}}},
+		"expires": {"validation": {"number_validator": {"min": 60}}},
+		"scope": {"inline_array": true, "inline_array_seperator": " ", "validation": {"string_array_validator": {"regex": "^[a-z:]+$"}}},
+		"ids": {"validation": {"number_array_validator": {"max": 10}}}
+	}
+}`
+
+func TestSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	type args struct {
+		contentType string
+		body        string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=client_credentials&client_id=abc123&expires=3600",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: inline array and repeated keys",
+			args: args{
+				contentType: "application/x-www-form-urlencoded; charset=utf-8",
+				body:        "grant_type=client_credentials&scope=users:read+users:write&scope=admin&ids=1&ids=2",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: content type",
+			args: args{
+				contentType: "application/json",
+				body:        `{"grant_type": "client_credentials"}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: required",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=refresh_token&refresh_token=abc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: unknown",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=client_credentials&password=abc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: one of",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=password",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: multiple values",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=client_credentials&client_id=abc&client_id=def",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: repeated key value",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=client_credentials&ids=1&ids=20",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: inline array value",
+			args: args{
+				contentType: "application/x-www-form-urlencoded",
+				body:        "grant_type=client_credentials&scope=users:read+Admin",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []interface{ Validate(*http.Request) error }{schema, compiled} {
+				req := httptest.NewRequest(http.MethodPost, "http://www.test.com/token", strings.NewReader(tt.args.body))
+				req.Header.Set("Content-Type", tt.args.contentType)
+				if err := v.Validate(req); (err != nil) != tt.wantErr {
+					t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestSchema_Validate_FormValue(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	body := "grant_type=client_credentials&client_id=abc123"
+	req := httptest.NewRequest(http.MethodPost, "http://www.test.com/token?tenant=one", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := schema.Validate(req); err != nil {
+		t.Fatalf("Schema.Validate() error = %v", err)
+	}
+	if got := req.FormValue("client_id"); got != "abc123" {
+		t.Errorf("Request.FormValue() client_id = %s, want abc123", got)
+	}
+	if got := req.FormValue("tenant"); got != "one" {
+		t.Errorf("Request.FormValue() tenant = %s, want one", got)
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil || string(b) != body {
+		t.Errorf("Request.Body = %s, error = %v", string(b), err)
+	}
+}
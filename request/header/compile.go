@@ -0,0 +1,39 @@
+package header
+
+import (
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	compiled.RequiredFields = canonicalRequired(s.RequiredFields)
+	parameters, issues := parameter.CompileParameters(s.Parameters)
+	compiled.Parameters = parameters
+	if err := rerror.LintFromIssues("header schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate validates the request headers.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
+
+// ValidateHeader validates the headers.
+func (c *CompiledSchema) ValidateHeader(h http.Header) error {
+	return c.schema.ValidateHeader(h)
+}
+
+func (p ParameterProperties) Compile() (ParameterProperties, error) {
+	validation, err := p.Validation.Compile()
+	p.Validation = validation
+	return p, err
+}
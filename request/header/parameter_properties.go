@@ -0,0 +1,61 @@
+package header
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+)
+
+type ParameterValidation = parameter.Values
+
+type ParameterProperties struct {
+	Description          string              `json:"description"`
+	Example              string              `json:"example"`
+	InlineArray          bool                `json:"inline_array"`
+	InlineArraySeperator string              `json:"inline_array_seperator"`
+	Validation           ParameterValidation `json:"validation"`
+}
+
+func (p ParameterProperties) Validate(values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	if p.Validation.ValidatorValues() == nil {
+		if p.InlineArray {
+			values = p.split(values)
+		}
+		if err := p.Validation.ValidateValues("header", values); err != nil {
+			return fmt.Errorf("header validation: %w", err)
+		}
+		return nil
+	}
+	if len(values) > 1 {
+		return fmt.Errorf("header validation: multiple values present [%d]", len(values))
+	}
+	if err := p.Validation.ValidateValue("header", values[0]); err != nil {
+		return fmt.Errorf("header validation: %w", err)
+	}
+	return nil
+}
+
+func (p ParameterProperties) split(values []string) []string {
+	split := []string{}
+	for _, value := range values {
+		for _, v := range strings.Split(value, p.InlineArraySeperator) {
+			split = append(split, strings.TrimSpace(v))
+		}
+	}
+	return split
+}
+
+func SchemaModelParameterPropertiesValidator(properties ParameterProperties) error {
+	if err := properties.Validation.Validator(); err != nil {
+		return fmt.Errorf("propoerties data type error: %w", err)
+	}
+	if properties.InlineArray && len(properties.InlineArraySeperator) == 0 {
+		return errors.New("properties inline array requires a seperator")
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package header
+
+import (
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+)
+
+func TestSchemaModelParameterPropertiesValidator(t *testing.T) {
+	type args struct {
+		properties ParameterProperties
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				properties: ParameterProperties{
+					Description: "None",
+					Example:     "Test",
+					Validation: ParameterValidation{
+						String: &parameter.StringValidator{},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: bad data type",
+			args: args{
+				properties: ParameterProperties{
+					Description: "None",
+					Example:     "Test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: no inline array seperator",
+			args: args{
+				properties: ParameterProperties{
+					Description: "None",
+					Example:     "Test",
+					InlineArray: true,
+					Validation: ParameterValidation{
+						StringArray: &parameter.StringArrayValidator{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SchemaModelParameterPropertiesValidator(tt.args.properties); (err != nil) != tt.wantErr {
+				t.Errorf("SchemaModelParameterPropertiesValidator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParameterProperties_Validate(t *testing.T) {
+	type fields struct {
+		InlineArray          bool
+		InlineArraySeperator string
+		Validation           ParameterValidation
+	}
+	type args struct {
+		values []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success: not present",
+			fields: fields{
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{},
+				},
+			},
+			args: args{
+				values: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: value",
+			fields: fields{
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{
+						RegEx: func() *string {
+							s := parameter.RegExUUIDv4
+							return &s
+						}(),
+					},
+				},
+			},
+			args: args{
+				values: []string{"8b4a60d8-c203-460f-92eb-82646c93d792"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: multiple values",
+			fields: fields{
+				Validation: ParameterValidation{
+					NumberArray: &parameter.NumberArrayValidator{},
+				},
+			},
+			args: args{
+				values: []string{"1", "2"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: inline array",
+			fields: fields{
+				InlineArray:          true,
+				InlineArraySeperator: ",",
+				Validation: ParameterValidation{
+					StringArray: &parameter.StringArrayValidator{
+						Present: []string{"en-US", "fr"},
+					},
+				},
+			},
+			args: args{
+				values: []string{"en-US, de", "fr"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: multiple values",
+			fields: fields{
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{},
+				},
+			},
+			args: args{
+				values: []string{"one", "two"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: value",
+			fields: fields{
+				Validation: ParameterValidation{
+					Number: &parameter.NumberValidator{},
+				},
+			},
+			args: args{
+				values: []string{"one"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := ParameterProperties{
+				InlineArray:          tt.fields.InlineArray,
+				InlineArraySeperator: tt.fields.InlineArraySeperator,
+				Validation:           tt.fields.Validation,
+			}
+			if err := p.Validate(tt.args.values); (err != nil) != tt.wantErr {
+				t.Errorf("ParameterProperties.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package header
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type Schema struct {
+	Title          string                         `json:"title"`
+	Description    string                         `json:"description"`
+	RequiredFields field.Required                 `json:"required_fields"`
+	Parameters     map[string]ParameterProperties `json:"parameters"`
+}
+
+func (s Schema) Validate(req *http.Request) error {
+	return rerror.SchemaFromError("request header validation", s.ValidateHeader(req.Header))
+}
+
+func (s Schema) ValidateHeader(h http.Header) error {
+	set := map[string]struct{}{}
+	for key := range h {
+		set[http.CanonicalHeaderKey(key)] = struct{}{}
+	}
+
+	if err := canonicalRequired(s.RequiredFields).Validate(set); err != nil {
+		return err
+	}
+
+	parameterErr := &rerror.ParameterErr{
+		Parameters: map[string]string{},
+	}
+	for key, properties := range s.Parameters {
+		key = http.CanonicalHeaderKey(key)
+		if err := properties.Validate(h.Values(key)); err != nil {
+			parameterErr.Add(key, err.Error())
+		}
+	}
+	if !parameterErr.Has() {
+		return nil
+	}
+	return parameterErr
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case len(schema.Parameters) == 0:
+		return errors.New("schema parameters is required")
+	default:
+	}
+	parameters := map[string]struct{}{}
+	for param, properties := range schema.Parameters {
+		if err := SchemaModelParameterPropertiesValidator(properties); err != nil {
+			return fmt.Errorf("schema parameter [%s]: %w", param, err)
+		}
+		key := http.CanonicalHeaderKey(param)
+		if _, has := parameters[key]; has {
+			return fmt.Errorf("schema parameter [%s]: duplicate header name [%s]", param, key)
+		}
+		parameters[key] = struct{}{}
+	}
+	if err := canonicalRequired(schema.RequiredFields).Validate(parameters); err != nil {
+		return fmt.Errorf("schema required parameters missing: %w", err)
+	}
+	return nil
+}
+
+// canonicalRequired returns the required fields with the header names in
+// canonical form, header names are case insensitive.
+func canonicalRequired(required field.Required) field.Required {
+	canonical := field.Required{}
+	for _, oneOf := range required.OneOf {
+		canonical.OneOf = append(canonical.OneOf, canonicalKeys(oneOf))
+	}
+	if len(required.Present) > 0 {
+		canonical.Present = map[string][]string{}
+		for key, present := range required.Present {
+			canonical.Present[http.CanonicalHeaderKey(key)] = canonicalKeys(present)
+		}
+	}
+	return canonical
+}
+
+func canonicalKeys(keys []string) []string {
+	canonical := make([]string, len(keys))
+	for i, key := range keys {
+		canonical[i] = http.CanonicalHeaderKey(key)
+	}
+	return canonical
+}
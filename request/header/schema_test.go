@@ -0,0 +1,200 @@
+package header
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+)
+
+func TestSchemaModelValidator(t *testing.T) {
+	type args struct {
+		schema Schema
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+					RequiredFields: field.Required{
+						OneOf: [][]string{{"x-request-id"}},
+					},
+					Parameters: map[string]ParameterProperties{
+						"X-Request-ID": {
+							Validation: ParameterValidation{
+								String: &parameter.StringValidator{},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: no parameters",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: duplicate header",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+					Parameters: map[string]ParameterProperties{
+						"X-Request-ID": {
+							Validation: ParameterValidation{
+								String: &parameter.StringValidator{},
+							},
+						},
+						"x-request-id": {
+							Validation: ParameterValidation{
+								String: &parameter.StringValidator{},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: required not a parameter",
+			args: args{
+				schema: Schema{
+					Title: "Test",
+					RequiredFields: field.Required{
+						OneOf: [][]string{{"X-Api-Version"}},
+					},
+					Parameters: map[string]ParameterProperties{
+						"X-Request-ID": {
+							Validation: ParameterValidation{
+								String: &parameter.StringValidator{},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SchemaModelValidator(tt.args.schema); (err != nil) != tt.wantErr {
+				t.Errorf("SchemaModelValidator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := Schema{
+		Title: "Header Test",
+		RequiredFields: field.Required{
+			OneOf: [][]string{{"x-request-id"}},
+		},
+		Parameters: map[string]ParameterProperties{
+			"x-request-id": {
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{
+						RegEx: func() *string {
+							s := parameter.RegExUUIDv4
+							return &s
+						}(),
+					},
+				},
+			},
+			"X-Api-Version": {
+				Validation: ParameterValidation{
+					String: &parameter.StringValidator{
+						OneOf: []string{"v1", "v2"},
+					},
+				},
+			},
+			"Accept-Language": {
+				InlineArray:          true,
+				InlineArraySeperator: ",",
+				Validation: ParameterValidation{
+					StringArray: &parameter.StringArrayValidator{
+						RegEx: func() *string {
+							s := "^[a-z]{2}(-[A-Z]{2})?(;q=[0-9.]+)?$"
+							return &s
+						}(),
+					},
+				},
+			},
+		},
+	}
+	type args struct {
+		headers map[string][]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				headers: map[string][]string{
+					"X-Request-Id":    {"8b4a60d8-c203-460f-92eb-82646c93d792"},
+					"X-Api-Version":   {"v2"},
+					"Accept-Language": {"en-US,fr;q=0.8", "de"},
+					"User-Agent":      {"test"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: required",
+			args: args{
+				headers: map[string][]string{
+					"X-Api-Version": {"v2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: one of",
+			args: args{
+				headers: map[string][]string{
+					"X-Request-Id":  {"8b4a60d8-c203-460f-92eb-82646c93d792"},
+					"X-Api-Version": {"v3"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: array",
+			args: args{
+				headers: map[string][]string{
+					"X-Request-Id":    {"8b4a60d8-c203-460f-92eb-82646c93d792"},
+					"Accept-Language": {"english"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://www.header-example.com", nil)
+			for key, values := range tt.args.headers {
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			if err := schema.Validate(req); (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
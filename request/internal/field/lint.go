@@ -0,0 +1,39 @@
+package field
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Lint returns the required fields that are not in the fields.
+func (r Required) Lint(path string, fields map[string]struct{}) []rerror.LintIssue {
+	issues := []rerror.LintIssue{}
+	missing := func(p string, name string) {
+		if _, has := fields[name]; !has {
+			issues = append(issues, rerror.LintIssue{
+				Path: p,
+				Msg:  fmt.Sprintf("required field [%s] is not a parameter", name),
+			})
+		}
+	}
+	for i, combination := range r.OneOf {
+		for j, name := range combination {
+			missing(fmt.Sprintf("%s/one_of/%d/%d", path, i, j), name)
+		}
+	}
+	present := make([]string, 0, len(r.Present))
+	for name := range r.Present {
+		present = append(present, name)
+	}
+	sort.Strings(present)
+	for _, name := range present {
+		p := path + "/present/" + rerror.PointerToken(name)
+		missing(p, name)
+		for j, required := range r.Present[name] {
+			missing(fmt.Sprintf("%s/%d", p, j), required)
+		}
+	}
+	return issues
+}
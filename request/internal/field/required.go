@@ -11,6 +11,24 @@ type Required struct {
 	Present map[string][]string `json:"present"`
 }
 
+// Clone returns a deep copy of the required fields.
+func (r Required) Clone() Required {
+	c := Required{}
+	if r.OneOf != nil {
+		c.OneOf = make([][]string, len(r.OneOf))
+		for i, fields := range r.OneOf {
+			c.OneOf[i] = append([]string(nil), fields...)
+		}
+	}
+	if r.Present != nil {
+		c.Present = make(map[string][]string, len(r.Present))
+		for k, fields := range r.Present {
+			c.Present[k] = append([]string(nil), fields...)
+		}
+	}
+	return c
+}
+
 func (r Required) Validate(fields map[string]struct{}) error {
 	if err := findOneOf(r.OneOf, fields); err != nil {
 		return err
@@ -8,6 +8,12 @@ type BooleanValidator struct {
 	Value *bool `json:"value"`
 }
 
+// Compile returns a copy of the validator.
+func (p BooleanValidator) Compile() (BooleanValidator, error) {
+	p.Value = clonePtr(p.Value)
+	return p, nil
+}
+
 func (p BooleanValidator) Validate(value bool) error {
 	if p.Value != nil && *p.Value != value {
 		return fmt.Errorf("value [%v] does not equal %v", value, *p.Value)
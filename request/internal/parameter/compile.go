@@ -0,0 +1,68 @@
+package parameter
+
+import (
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Compile returns the compiled copy of the validator that v references, nil
+// is returned when v is nil.
+func Compile[T interface{ Compile() (T, error) }](v *T) (*T, error) {
+	if v == nil {
+		return nil, nil
+	}
+	compiled, err := (*v).Compile()
+	if err != nil {
+		return nil, err
+	}
+	return &compiled, nil
+}
+
+// CompileParameters compiles the properties of each of the parameters, a
+// parameter that fails is left out of the map and reported as an issue.
+func CompileParameters[P interface{ Compile() (P, error) }](params map[string]P) (map[string]P, []rerror.LintIssue) {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	compiled := make(map[string]P, len(params))
+	issues := []rerror.LintIssue{}
+	for _, name := range names {
+		properties, err := params[name].Compile()
+		if err != nil {
+			issues = append(issues, rerror.LintIssue{
+				Path: "/parameters/" + rerror.PointerToken(name) + "/validation",
+				Msg:  err.Error(),
+			})
+			continue
+		}
+		compiled[name] = properties
+	}
+	return compiled, issues
+}
+
+func set[T comparable](values []T) map[T]struct{} {
+	s := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
+
+func cloneSlice[T any](values []T) []T {
+	if values == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(values)), values...)
+}
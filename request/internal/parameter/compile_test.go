@@ -0,0 +1,230 @@
+package parameter
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	str := func(s string) *string { return &s }
+	type args struct {
+		validator any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success: nil",
+			args: args{
+				validator: (*StringValidator)(nil),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: string",
+			args: args{
+				validator: &StringValidator{RegEx: str("^[a-z]+$"), OneOf: []string{"abc"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: string reg exp",
+			args: args{
+				validator: &StringValidator{RegEx: str("[a-z")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: string array reg exp",
+			args: args{
+				validator: &StringArrayValidator{RegEx: str("[a-z")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success: time",
+			args: args{
+				validator: &TimeValidator{Format: "2006-01-02", Before: str("2030-01-01")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: time before",
+			args: args{
+				validator: &TimeValidator{Format: "2006-01-02", Before: str("01/01/2030")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: time format",
+			args: args{
+				validator: &TimeValidator{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: time array after",
+			args: args{
+				validator: &TimeArrayValidator{Format: "2006-01-02", After: str("01/01/2030")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			switch v := tt.args.validator.(type) {
+			case *StringValidator:
+				_, err = Compile(v)
+			case *StringArrayValidator:
+				_, err = Compile(v)
+			case *TimeValidator:
+				_, err = Compile(v)
+			case *TimeArrayValidator:
+				_, err = Compile(v)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompile_Validate(t *testing.T) {
+	regEx := "^[a-z]+$"
+	before := "2030-01-01"
+	validator := StringValidator{RegEx: &regEx, OneOf: []string{"abc", "def"}}
+	compiled, err := validator.Compile()
+	if err != nil {
+		t.Fatalf("StringValidator.Compile() error = %v", err)
+	}
+	timeValidator := TimeValidator{Format: "2006-01-02", Before: &before}
+	compiledTime, err := timeValidator.Compile()
+	if err != nil {
+		t.Fatalf("TimeValidator.Compile() error = %v", err)
+	}
+	numberValidator := NumberValidator{OneOf: []float64{1, 2}}
+	compiledNumber, err := numberValidator.Compile()
+	if err != nil {
+		t.Fatalf("NumberValidator.Compile() error = %v", err)
+	}
+
+	for _, value := range []string{"abc", "ghi", "ABC"} {
+		if got, want := compiled.Validate(value), validator.Validate(value); (got != nil) != (want != nil) || (got != nil && got.Error() != want.Error()) {
+			t.Errorf("StringValidator.Validate(%s) compiled error = %v, want %v", value, got, want)
+		}
+	}
+	for _, value := range []string{"2020-01-01", "2040-01-01", "01/01/2020"} {
+		if got, want := compiledTime.Validate(value), timeValidator.Validate(value); (got != nil) != (want != nil) || (got != nil && got.Error() != want.Error()) {
+			t.Errorf("TimeValidator.Validate(%s) compiled error = %v, want %v", value, got, want)
+		}
+	}
+	for _, value := range []float64{1, 3} {
+		if got, want := compiledNumber.Validate(value), numberValidator.Validate(value); (got != nil) != (want != nil) || (got != nil && got.Error() != want.Error()) {
+			t.Errorf("NumberValidator.Validate(%f) compiled error = %v, want %v", value, got, want)
+		}
+	}
+
+	regEx = "^[0-9]+$"
+	if err := compiled.Validate("abc"); err != nil {
+		t.Errorf("StringValidator.Validate() compiled validator changed with the source, error = %v", err)
+	}
+}
+
+func TestCompileParameters(t *testing.T) {
+	str := func(s string) *string { return &s }
+	type args struct {
+		params map[string]Values
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantParams []string
+		wantPaths  []string
+	}{
+		{
+			name: "success",
+			args: args{
+				params: map[string]Values{
+					"name": {String: &StringValidator{RegEx: str("^[a-z]+$")}},
+					"age":  {Number: &NumberValidator{}},
+				},
+			},
+			wantParams: []string{"age", "name"},
+			wantPaths:  []string{},
+		},
+		{
+			name: "fail: reg exps",
+			args: args{
+				params: map[string]Values{
+					"b/c":  {String: &StringValidator{RegEx: str("[a-z")}},
+					"a":    {StringArray: &StringArrayValidator{RegEx: str("[a-z")}},
+					"name": {String: &StringValidator{}},
+				},
+			},
+			wantParams: []string{"name"},
+			wantPaths:  []string{"/parameters/a/validation", "/parameters/b~1c/validation"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, issues := CompileParameters(tt.args.params)
+			names := []string{}
+			for name := range params {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, tt.wantParams) {
+				t.Errorf("CompileParameters() params = %v, want %v", names, tt.wantParams)
+			}
+			paths := []string{}
+			for _, issue := range issues {
+				paths = append(paths, issue.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("CompileParameters() issues = %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func BenchmarkStringValidator_Validate(b *testing.B) {
+	regEx := "^[a-z]+-[0-9]+$"
+	validator := StringValidator{RegEx: &regEx}
+	b.Run("schema", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = validator.Validate("abc-123")
+		}
+	})
+	compiled, err := validator.Compile()
+	if err != nil {
+		b.Fatalf("StringValidator.Compile() error = %v", err)
+	}
+	b.Run("compiled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = compiled.Validate("abc-123")
+		}
+	})
+}
+
+func BenchmarkTimeValidator_Validate(b *testing.B) {
+	before, after := "2030-01-01T00:00:00Z", "2000-01-01T00:00:00Z"
+	validator := TimeValidator{Format: "2006-01-02T15:04:05Z07:00", Before: &before, After: &after}
+	b.Run("schema", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = validator.Validate("2020-06-01T12:00:00Z")
+		}
+	})
+	compiled, err := validator.Compile()
+	if err != nil {
+		b.Fatalf("TimeValidator.Compile() error = %v", err)
+	}
+	b.Run("compiled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = compiled.Validate("2020-06-01T12:00:00Z")
+		}
+	})
+}
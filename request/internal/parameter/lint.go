@@ -0,0 +1,200 @@
+package parameter
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type issues []rerror.LintIssue
+
+func (i *issues) add(path, format string, args ...any) {
+	*i = append(*i, rerror.LintIssue{
+		Path: path,
+		Msg:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Lint returns the string validator rules that contradict each other.
+func (p StringValidator) Lint(path string) []rerror.LintIssue {
+	var found issues
+	regEx := lintRegEx(path, p.RegEx, &found)
+	if p.Value != nil {
+		switch {
+		case len(*p.Value) == 0:
+			found.add(path+"/value", "value is empty and a value must be present")
+		case regEx != nil && !regEx.MatchString(*p.Value):
+			found.add(path+"/value", "value [%s] does not match reg exp %s", *p.Value, *p.RegEx)
+		}
+		if len(p.OneOf) > 0 && !contains(p.OneOf, *p.Value) {
+			found.add(path+"/value", "value [%s] not in one of %v", *p.Value, p.OneOf)
+		}
+	}
+	for i, s := range p.OneOf {
+		switch {
+		case len(s) == 0:
+			found.add(fmt.Sprintf("%s/one_of/%d", path, i), "one of value is empty and a value must be present")
+		case regEx != nil && !regEx.MatchString(s):
+			found.add(fmt.Sprintf("%s/one_of/%d", path, i), "one of value [%s] does not match reg exp %s", s, *p.RegEx)
+		}
+	}
+	return found
+}
+
+// Lint returns the string array validator rules that contradict each other.
+func (s StringArrayValidator) Lint(path string) []rerror.LintIssue {
+	var found issues
+	regEx := lintRegEx(path, s.RegEx, &found)
+	for i, v := range s.Values {
+		if regEx != nil && !regEx.MatchString(v) {
+			found.add(fmt.Sprintf("%s/values/%d", path, i), "value [%s] does not match reg exp %s", v, *s.RegEx)
+		}
+	}
+	for i, v := range s.Present {
+		switch {
+		case regEx != nil && !regEx.MatchString(v):
+			found.add(fmt.Sprintf("%s/present/%d", path, i), "present value [%s] does not match reg exp %s", v, *s.RegEx)
+		case len(s.Values) > 0 && !contains(s.Values, v):
+			found.add(fmt.Sprintf("%s/present/%d", path, i), "present value [%s] not in values %v", v, s.Values)
+		}
+	}
+	return found
+}
+
+// Lint returns the number validator rules that contradict each other.
+func (p NumberValidator) Lint(path string) []rerror.LintIssue {
+	var found issues
+	lintRange(path, p.Min, p.Max, &found)
+	if p.Value != nil {
+		lintInRange(path+"/value", *p.Value, p.Min, p.Max, &found)
+		if len(p.OneOf) > 0 && !contains(p.OneOf, *p.Value) {
+			found.add(path+"/value", "value [%v] not in one of %v", *p.Value, p.OneOf)
+		}
+	}
+	for i, n := range p.OneOf {
+		lintInRange(fmt.Sprintf("%s/one_of/%d", path, i), n, p.Min, p.Max, &found)
+	}
+	return found
+}
+
+// Lint returns the number array validator rules that contradict each other.
+func (n NumberArrayValidator) Lint(path string) []rerror.LintIssue {
+	var found issues
+	lintRange(path, n.Min, n.Max, &found)
+	for i, v := range n.Values {
+		lintInRange(fmt.Sprintf("%s/values/%d", path, i), v, n.Min, n.Max, &found)
+	}
+	for i, v := range n.Present {
+		lintInRange(fmt.Sprintf("%s/present/%d", path, i), v, n.Min, n.Max, &found)
+		if len(n.Values) > 0 && !contains(n.Values, v) {
+			found.add(fmt.Sprintf("%s/present/%d", path, i), "present value [%v] not in values %v", v, n.Values)
+		}
+	}
+	return found
+}
+
+// Lint returns the time validator rules that contradict each other, or can
+// not be parsed with the format.
+func (p TimeValidator) Lint(path string) []rerror.LintIssue {
+	var found issues
+	if len(p.Format) == 0 {
+		found.add(path+"/format", "time format is required")
+		return found
+	}
+	before, after := lintTimeRange(path, p.Format, p.Before, p.After, &found)
+	if p.Value != nil {
+		lintTime(path+"/value", p.Format, *p.Value, before, after, &found)
+	}
+	return found
+}
+
+// Lint returns the time array validator rules that contradict each other, or
+// can not be parsed with the format.
+func (tav TimeArrayValidator) Lint(path string) []rerror.LintIssue {
+	var found issues
+	if len(tav.Format) == 0 {
+		found.add(path+"/format", "time format is required")
+		return found
+	}
+	before, after := lintTimeRange(path, tav.Format, tav.Before, tav.After, &found)
+	for i, v := range tav.Values {
+		lintTime(fmt.Sprintf("%s/values/%d", path, i), tav.Format, v, before, after, &found)
+	}
+	return found
+}
+
+func lintRegEx(path string, expr *string, found *issues) *regexp.Regexp {
+	if expr == nil {
+		return nil
+	}
+	regEx, err := regexp.Compile(*expr)
+	if err != nil {
+		found.add(path+"/regex", "reg exp [%s] error %v", *expr, err)
+		return nil
+	}
+	return regEx
+}
+
+func lintRange(path string, min, max *float64, found *issues) {
+	if min != nil && max != nil && *min > *max {
+		found.add(path+"/min", "min [%v] is greater than max [%v]", *min, *max)
+	}
+}
+
+func lintInRange(path string, num float64, min, max *float64, found *issues) {
+	if min != nil && num < *min {
+		found.add(path, "value [%v] is less than min [%v]", num, *min)
+	}
+	if max != nil && num > *max {
+		found.add(path, "value [%v] is greater than max [%v]", num, *max)
+	}
+}
+
+func lintTimeRange(path, format string, before, after *string, found *issues) (*time.Time, *time.Time) {
+	var b, a *time.Time
+	if before != nil {
+		t, err := time.Parse(format, *before)
+		if err != nil {
+			found.add(path+"/before", "before [%s] parsing err: %v", *before, err)
+		} else {
+			b = &t
+		}
+	}
+	if after != nil {
+		t, err := time.Parse(format, *after)
+		if err != nil {
+			found.add(path+"/after", "after [%s] parsing err: %v", *after, err)
+		} else {
+			a = &t
+		}
+	}
+	if b != nil && a != nil && !a.Before(*b) {
+		found.add(path+"/before", "before [%s] is not after [%s] so no time is valid", *before, *after)
+	}
+	return b, a
+}
+
+func lintTime(path, format, value string, before, after *time.Time, found *issues) {
+	t, err := time.Parse(format, value)
+	if err != nil {
+		found.add(path, "value [%s] parsing err: %v", value, err)
+		return
+	}
+	if before != nil && !t.Before(*before) {
+		found.add(path, "value [%s] is not before [%s]", value, before.Format(format))
+	}
+	if after != nil && !t.After(*after) {
+		found.add(path, "value [%s] is not after [%s]", value, after.Format(format))
+	}
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package parameter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func lintPaths(issues []rerror.LintIssue) []string {
+	paths := []string{}
+	for _, issue := range issues {
+		paths = append(paths, issue.Path)
+	}
+	return paths
+}
+
+func TestLint(t *testing.T) {
+	str := func(s string) *string { return &s }
+	num := func(f float64) *float64 { return &f }
+	tests := []struct {
+		name      string
+		validator interface {
+			Lint(path string) []rerror.LintIssue
+		}
+		want []string
+	}{
+		{
+			name: "string: valid",
+			validator: StringValidator{
+				Value: str("a"),
+				RegEx: str("^[a-z]$"),
+				OneOf: []string{"a", "b"},
+			},
+			want: []string{},
+		},
+		{
+			name: "string: contradictions",
+			validator: StringValidator{
+				Value: str("c"),
+				RegEx: str("^[a-b]$"),
+				OneOf: []string{"a", "B"},
+			},
+			want: []string{"/s/value", "/s/value", "/s/one_of/1"},
+		},
+		{
+			name: "string: invalid reg exp",
+			validator: StringValidator{
+				RegEx: str("^[a-z$"),
+			},
+			want: []string{"/s/regex"},
+		},
+		{
+			name: "string array: present",
+			validator: StringArrayValidator{
+				Values:  []string{"a"},
+				Present: []string{"b"},
+			},
+			want: []string{"/s/present/0"},
+		},
+		{
+			name: "number: contradictions",
+			validator: NumberValidator{
+				Value: num(5),
+				Min:   num(10),
+				Max:   num(1),
+				OneOf: []float64{1},
+			},
+			want: []string{"/s/min", "/s/value", "/s/value", "/s/value", "/s/one_of/0"},
+		},
+		{
+			name: "number array: values",
+			validator: NumberArrayValidator{
+				Values: []float64{0, 5},
+				Min:    num(1),
+			},
+			want: []string{"/s/values/0"},
+		},
+		{
+			name: "time: contradictions",
+			validator: TimeValidator{
+				Format: "2006-01-02",
+				Value:  str("2020-01-02T00:00:00Z"),
+				Before: str("2020-01-01"),
+				After:  str("2021-01-01"),
+			},
+			want: []string{"/s/before", "/s/value"},
+		},
+		{
+			name: "time: outside range",
+			validator: TimeValidator{
+				Format: "2006-01-02",
+				Value:  str("2022-01-02"),
+				Before: str("2021-01-01"),
+			},
+			want: []string{"/s/value"},
+		},
+		{
+			name:      "time: format",
+			validator: TimeValidator{},
+			want:      []string{"/s/format"},
+		},
+		{
+			name: "time array: after",
+			validator: TimeArrayValidator{
+				Format: "2006-01-02",
+				Values: []string{"2020-01-02"},
+				After:  str("2021"),
+			},
+			want: []string{"/s/after"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lintPaths(tt.validator.Lint("/s")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,18 @@ type NumberValidator struct {
 	Min   *float64  `json:"min"`
 	Max   *float64  `json:"max"`
 	OneOf []float64 `json:"one_of"`
+
+	oneOf map[float64]struct{}
+}
+
+// Compile returns a copy of the validator with the one of values in a set.
+func (p NumberValidator) Compile() (NumberValidator, error) {
+	p.Value = clonePtr(p.Value)
+	p.Min = clonePtr(p.Min)
+	p.Max = clonePtr(p.Max)
+	p.OneOf = cloneSlice(p.OneOf)
+	p.oneOf = set(p.OneOf)
+	return p, nil
 }
 
 func (p NumberValidator) Validate(num float64) error {
@@ -25,6 +37,12 @@ func (p NumberValidator) Validate(num float64) error {
 	if len(p.OneOf) == 0 {
 		return nil
 	}
+	if p.oneOf != nil {
+		if _, has := p.oneOf[num]; has {
+			return nil
+		}
+		return fmt.Errorf("value [%f] not in %v", num, p.OneOf)
+	}
 	for _, n := range p.OneOf {
 		if num == n {
 			return nil
@@ -40,6 +58,15 @@ type NumberArrayValidator struct {
 	Present []float64 `json:"present"`
 }
 
+// Compile returns a copy of the validator.
+func (n NumberArrayValidator) Compile() (NumberArrayValidator, error) {
+	n.Values = cloneSlice(n.Values)
+	n.Min = clonePtr(n.Min)
+	n.Max = clonePtr(n.Max)
+	n.Present = cloneSlice(n.Present)
+	return n, nil
+}
+
 func (n NumberArrayValidator) Validate(nums []float64) error {
 	if len(n.Values) > 0 {
 		if len(n.Values) != len(nums) {
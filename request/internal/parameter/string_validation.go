@@ -14,6 +14,33 @@ type StringValidator struct {
 	Value *string  `json:"value"`
 	RegEx *string  `json:"regex"`
 	OneOf []string `json:"one_of"`
+
+	compiled *compiledString
+}
+
+type compiledString struct {
+	regEx *regexp.Regexp
+	oneOf map[string]struct{}
+}
+
+// Compile returns a copy of the validator with the reg exp compiled and the
+// one of values in a set.
+func (p StringValidator) Compile() (StringValidator, error) {
+	p.Value = clonePtr(p.Value)
+	p.RegEx = clonePtr(p.RegEx)
+	p.OneOf = cloneSlice(p.OneOf)
+	compiled := &compiledString{
+		oneOf: set(p.OneOf),
+	}
+	if p.RegEx != nil {
+		regEx, err := regexp.Compile(*p.RegEx)
+		if err != nil {
+			return StringValidator{}, fmt.Errorf("reg exp [%s] error %w", *p.RegEx, err)
+		}
+		compiled.regEx = regEx
+	}
+	p.compiled = compiled
+	return p, nil
 }
 
 func (p StringValidator) Validate(value string) error {
@@ -23,6 +50,15 @@ func (p StringValidator) Validate(value string) error {
 	if p.Value != nil && *p.Value != value {
 		return fmt.Errorf("value [%s] does not equal %s", value, *p.Value)
 	}
+	if p.compiled != nil {
+		if p.compiled.regEx != nil && !p.compiled.regEx.MatchString(value) {
+			return fmt.Errorf("value [%s] does not match reg exp %s", value, *p.RegEx)
+		}
+		if _, has := p.compiled.oneOf[value]; len(p.OneOf) > 0 && !has {
+			return fmt.Errorf("value [%s] not in %v", value, p.OneOf)
+		}
+		return nil
+	}
 	if p.RegEx != nil {
 		match, err := regexp.MatchString(*p.RegEx, value)
 		switch {
@@ -48,6 +84,24 @@ type StringArrayValidator struct {
 	Values  []string `json:"values"`
 	RegEx   *string  `json:"regex"`
 	Present []string `json:"present"`
+
+	regEx *regexp.Regexp
+}
+
+// Compile returns a copy of the validator with the reg exp compiled.
+func (s StringArrayValidator) Compile() (StringArrayValidator, error) {
+	s.Values = cloneSlice(s.Values)
+	s.RegEx = clonePtr(s.RegEx)
+	s.Present = cloneSlice(s.Present)
+	if s.RegEx == nil {
+		return s, nil
+	}
+	regEx, err := regexp.Compile(*s.RegEx)
+	if err != nil {
+		return StringArrayValidator{}, fmt.Errorf("reg exp [%s] error %w", *s.RegEx, err)
+	}
+	s.regEx = regEx
+	return s, nil
 }
 
 func (s StringArrayValidator) Validate(values []string) error {
@@ -62,9 +116,12 @@ func (s StringArrayValidator) Validate(values []string) error {
 		}
 	}
 	if s.RegEx != nil {
-		reqEx, err := regexp.Compile(*s.RegEx)
-		if err != nil {
-			return fmt.Errorf("reg exp [%s] error %w", *s.RegEx, err)
+		reqEx := s.regEx
+		if reqEx == nil {
+			var err error
+			if reqEx, err = regexp.Compile(*s.RegEx); err != nil {
+				return fmt.Errorf("reg exp [%s] error %w", *s.RegEx, err)
+			}
 		}
 		for _, value := range values {
 			if !reqEx.MatchString(value) {
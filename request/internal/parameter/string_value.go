@@ -0,0 +1,40 @@
+package parameter
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// The string value validators parse the values of a query, header or cookie,
+// the source is the name of where the value is from in the errors.
+
+// ValidateString validates the boolean of the string value.
+func (p BooleanValidator) ValidateString(source, value string) error {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("%s value is not a boolean [%s] %w", source, value, err)
+	}
+	return p.Validate(b)
+}
+
+// ValidateString validates the number of the string value.
+func (p NumberValidator) ValidateString(source, value string) error {
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("%s value is not a number [%s] %w", source, value, err)
+	}
+	return p.Validate(num)
+}
+
+// ValidateStrings validates the numbers of the string values.
+func (n NumberArrayValidator) ValidateStrings(source string, values []string) error {
+	nums := make([]float64, len(values))
+	for i, value := range values {
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s value is not a number [%s] %w", source, value, err)
+		}
+		nums[i] = num
+	}
+	return n.Validate(nums)
+}
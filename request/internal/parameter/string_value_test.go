@@ -0,0 +1,149 @@
+package parameter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBooleanValidator_ValidateString(t *testing.T) {
+	value := true
+	type args struct {
+		source string
+		value  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				source: "header",
+				value:  "true",
+			},
+		},
+		{
+			name: "fail: value",
+			args: args{
+				source: "header",
+				value:  "false",
+			},
+			wantErr: "value [false] does not equal true",
+		},
+		{
+			name: "fail: not a boolean",
+			args: args{
+				source: "cookie",
+				value:  "yes",
+			},
+			wantErr: "cookie value is not a boolean [yes]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := BooleanValidator{Value: &value}
+			assertErr(t, p.ValidateString(tt.args.source, tt.args.value), tt.wantErr)
+		})
+	}
+}
+
+func TestNumberValidator_ValidateString(t *testing.T) {
+	min := 1.0
+	max := 10.0
+	type args struct {
+		source string
+		value  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				source: "query",
+				value:  "5.5",
+			},
+		},
+		{
+			name: "fail: range",
+			args: args{
+				source: "query",
+				value:  "11",
+			},
+			wantErr: "is greater than 10",
+		},
+		{
+			name: "fail: not a number",
+			args: args{
+				source: "header",
+				value:  "ten",
+			},
+			wantErr: "header value is not a number [ten]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NumberValidator{Min: &min, Max: &max}
+			assertErr(t, p.ValidateString(tt.args.source, tt.args.value), tt.wantErr)
+		})
+	}
+}
+
+func TestNumberArrayValidator_ValidateStrings(t *testing.T) {
+	max := 10.0
+	type args struct {
+		source string
+		values []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				source: "cookie",
+				values: []string{"1", "2.5"},
+			},
+		},
+		{
+			name: "fail: range",
+			args: args{
+				source: "cookie",
+				values: []string{"1", "20"},
+			},
+			wantErr: "is greater than 10",
+		},
+		{
+			name: "fail: not a number",
+			args: args{
+				source: "cookie",
+				values: []string{"1", "two"},
+			},
+			wantErr: "cookie value is not a number [two]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumberArrayValidator{Max: &max}
+			assertErr(t, n.ValidateStrings(tt.args.source, tt.args.values), tt.wantErr)
+		})
+	}
+}
+
+func assertErr(t *testing.T, err error, want string) {
+	t.Helper()
+	if len(want) == 0 {
+		if err != nil {
+			t.Errorf("error = %v", err)
+		}
+		return
+	}
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
@@ -11,6 +11,22 @@ type TimeValidator struct {
 	Value  *string `json:"value"`
 	Before *string `json:"before"`
 	After  *string `json:"after"`
+
+	bounds *timeBounds
+}
+
+// Compile returns a copy of the validator with the before and after times
+// parsed.
+func (p TimeValidator) Compile() (TimeValidator, error) {
+	p.Value = clonePtr(p.Value)
+	p.Before = clonePtr(p.Before)
+	p.After = clonePtr(p.After)
+	bounds, err := parseBounds(p.Format, p.Before, p.After)
+	if err != nil {
+		return TimeValidator{}, err
+	}
+	p.bounds = bounds
+	return p, nil
 }
 
 func (p TimeValidator) Validate(value string) error {
@@ -24,23 +40,17 @@ func (p TimeValidator) Validate(value string) error {
 	if p.Value != nil && *p.Value != value {
 		return fmt.Errorf("value [%s] does not match expected [%s]", value, *p.Value)
 	}
-	if p.Before != nil {
-		b, err := time.Parse(p.Format, *p.Before)
-		if err != nil {
-			return fmt.Errorf("value [%s] parsing err: %w", *p.Before, err)
-		}
-		if !t.Before(b) {
-			return fmt.Errorf("value [%s] is not before [%s]", value, *p.Before)
+	bounds := p.bounds
+	if bounds == nil {
+		if bounds, err = parseBounds(p.Format, p.Before, p.After); err != nil {
+			return err
 		}
 	}
-	if p.After != nil {
-		b, err := time.Parse(p.Format, *p.After)
-		if err != nil {
-			return fmt.Errorf("value [%s] parsing err: %w", *p.After, err)
-		}
-		if !t.After(b) {
-			return fmt.Errorf("value [%s] is not after [%s]", value, *p.After)
-		}
+	if bounds.before != nil && !t.Before(*bounds.before) {
+		return fmt.Errorf("value [%s] is not before [%s]", value, *p.Before)
+	}
+	if bounds.after != nil && !t.After(*bounds.after) {
+		return fmt.Errorf("value [%s] is not after [%s]", value, *p.After)
 	}
 	return nil
 }
@@ -50,6 +60,22 @@ type TimeArrayValidator struct {
 	Values []string `json:"values"`
 	Before *string  `json:"before"`
 	After  *string  `json:"after"`
+
+	bounds *timeBounds
+}
+
+// Compile returns a copy of the validator with the before and after times
+// parsed.
+func (tav TimeArrayValidator) Compile() (TimeArrayValidator, error) {
+	tav.Values = cloneSlice(tav.Values)
+	tav.Before = clonePtr(tav.Before)
+	tav.After = clonePtr(tav.After)
+	bounds, err := parseBounds(tav.Format, tav.Before, tav.After)
+	if err != nil {
+		return TimeArrayValidator{}, err
+	}
+	tav.bounds = bounds
+	return tav, nil
 }
 
 func (tav TimeArrayValidator) Validate(values []string) error {
@@ -74,25 +100,47 @@ func (tav TimeArrayValidator) Validate(values []string) error {
 			}
 		}
 	}
+	bounds := tav.bounds
+	if bounds == nil && len(ts) > 0 {
+		var err error
+		if bounds, err = parseBounds(tav.Format, tav.Before, tav.After); err != nil {
+			return err
+		}
+	}
 	for _, t := range ts {
-		if tav.Before != nil {
-			b, err := time.Parse(tav.Format, *tav.Before)
-			if err != nil {
-				return fmt.Errorf("value [%s] parsing err: %w", *tav.Before, err)
-			}
-			if !t.Before(b) {
-				return fmt.Errorf("value [%s] is not before [%s]", t, *tav.Before)
-			}
+		if bounds.before != nil && !t.Before(*bounds.before) {
+			return fmt.Errorf("value [%s] is not before [%s]", t, *tav.Before)
 		}
-		if tav.After != nil {
-			b, err := time.Parse(tav.Format, *tav.After)
-			if err != nil {
-				return fmt.Errorf("value [%s] parsing err: %w", *tav.After, err)
-			}
-			if !t.After(b) {
-				return fmt.Errorf("value [%s] is not after [%s]", t, *tav.After)
-			}
+		if bounds.after != nil && !t.After(*bounds.after) {
+			return fmt.Errorf("value [%s] is not after [%s]", t, *tav.After)
 		}
 	}
 	return nil
 }
+
+type timeBounds struct {
+	before *time.Time
+	after  *time.Time
+}
+
+func parseBounds(format string, before, after *string) (*timeBounds, error) {
+	if len(format) == 0 {
+		return nil, errors.New("time format is required")
+	}
+	bounds := &timeBounds{}
+	if before != nil {
+		b, err := time.Parse(format, *before)
+		if err != nil {
+			return nil, fmt.Errorf("value [%s] parsing err: %w", *before, err)
+		}
+		bounds.before = &b
+	}
+	if after != nil {
+		a, err := time.Parse(format, *after)
+		if err != nil {
+			return nil, fmt.Errorf("value [%s] parsing err: %w", *after, err)
+		}
+		bounds.after = &a
+	}
+	return bounds, nil
+}
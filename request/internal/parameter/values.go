@@ -0,0 +1,121 @@
+package parameter
+
+import (
+	"errors"
+)
+
+// Values is the validation of a string parameter value, a header or cookie,
+// exactly one of the validators is set.
+type Values struct {
+	String      *StringValidator      `json:"string_validator"`
+	Number      *NumberValidator      `json:"number_validator"`
+	Time        *TimeValidator        `json:"time_validator"`
+	Boolean     *BooleanValidator     `json:"boolean_validator"`
+	StringArray *StringArrayValidator `json:"string_array_validator"`
+	TimeArray   *TimeArrayValidator   `json:"time_array_validator"`
+	NumberArray *NumberArrayValidator `json:"number_array_validator"`
+}
+
+// ValidateValues validates the values with the array validator, the source
+// is the name of where the values are from in the errors.
+func (p Values) ValidateValues(source string, values []string) error {
+	if err := p.ValidatorValues(); err != nil {
+		return err
+	}
+	switch {
+	case p.StringArray != nil:
+		return p.StringArray.Validate(values)
+	case p.NumberArray != nil:
+		return p.NumberArray.ValidateStrings(source, values)
+	case p.TimeArray != nil:
+		return p.TimeArray.Validate(values)
+	default:
+		return errors.New("unable to validate the parameter")
+	}
+}
+
+// ValidateValue validates the value with the single value validator, the
+// source is the name of where the value is from in the errors.
+func (p Values) ValidateValue(source, value string) error {
+	if err := p.validatorValue(); err != nil {
+		return err
+	}
+	switch {
+	case p.String != nil:
+		return p.String.Validate(value)
+	case p.Number != nil:
+		return p.Number.ValidateString(source, value)
+	case p.Time != nil:
+		return p.Time.Validate(value)
+	case p.Boolean != nil:
+		return p.Boolean.ValidateString(source, value)
+	default:
+		return errors.New("unable to validate the parameter")
+	}
+}
+
+// ValidatorValues returns an error unless exactly one array validator is set.
+func (p Values) ValidatorValues() error {
+	return one(p.StringArray != nil, p.NumberArray != nil, p.TimeArray != nil)
+}
+
+// Validator returns an error unless exactly one validator is set.
+func (p Values) Validator() error {
+	return one(
+		p.String != nil,
+		p.Number != nil,
+		p.Time != nil,
+		p.Boolean != nil,
+		p.StringArray != nil,
+		p.NumberArray != nil,
+		p.TimeArray != nil,
+	)
+}
+
+// Compile returns a copy of the validation with the validators compiled.
+func (p Values) Compile() (Values, error) {
+	var err error
+	if p.String, err = Compile(p.String); err != nil {
+		return Values{}, err
+	}
+	if p.Number, err = Compile(p.Number); err != nil {
+		return Values{}, err
+	}
+	if p.Time, err = Compile(p.Time); err != nil {
+		return Values{}, err
+	}
+	if p.Boolean, err = Compile(p.Boolean); err != nil {
+		return Values{}, err
+	}
+	if p.StringArray, err = Compile(p.StringArray); err != nil {
+		return Values{}, err
+	}
+	if p.TimeArray, err = Compile(p.TimeArray); err != nil {
+		return Values{}, err
+	}
+	if p.NumberArray, err = Compile(p.NumberArray); err != nil {
+		return Values{}, err
+	}
+	return p, nil
+}
+
+func (p Values) validatorValue() error {
+	return one(p.String != nil, p.Number != nil, p.Time != nil, p.Boolean != nil)
+}
+
+func one(set ...bool) error {
+	found := false
+	for _, s := range set {
+		if !s {
+			continue
+		}
+		if found {
+			return errors.New("parameter validation can't have more than one validator")
+		}
+		found = true
+	}
+	if !found {
+		return errors.New("paramter validation must have one validation")
+	}
+	return nil
+}
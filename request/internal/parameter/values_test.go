@@ -0,0 +1,152 @@
+package parameter
+
+import (
+	"testing"
+)
+
+func TestValues_ValidateValue(t *testing.T) {
+	max := 10.0
+	type args struct {
+		source string
+		value  string
+	}
+	tests := []struct {
+		name    string
+		values  Values
+		args    args
+		wantErr string
+	}{
+		{
+			name: "success",
+			values: Values{
+				Number: &NumberValidator{Max: &max},
+			},
+			args: args{
+				source: "header",
+				value:  "5",
+			},
+		},
+		{
+			name: "fail: not a number",
+			values: Values{
+				Number: &NumberValidator{Max: &max},
+			},
+			args: args{
+				source: "cookie",
+				value:  "five",
+			},
+			wantErr: "cookie value is not a number [five]",
+		},
+		{
+			name: "fail: array validator",
+			values: Values{
+				StringArray: &StringArrayValidator{},
+			},
+			args: args{
+				source: "header",
+				value:  "five",
+			},
+			wantErr: "paramter validation must have one validation",
+		},
+		{
+			name: "fail: more than one validator",
+			values: Values{
+				String:  &StringValidator{},
+				Boolean: &BooleanValidator{},
+			},
+			args: args{
+				source: "header",
+				value:  "true",
+			},
+			wantErr: "parameter validation can't have more than one validator",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErr(t, tt.values.ValidateValue(tt.args.source, tt.args.value), tt.wantErr)
+		})
+	}
+}
+
+func TestValues_ValidateValues(t *testing.T) {
+	type args struct {
+		source string
+		values []string
+	}
+	tests := []struct {
+		name    string
+		values  Values
+		args    args
+		wantErr string
+	}{
+		{
+			name: "success",
+			values: Values{
+				NumberArray: &NumberArrayValidator{},
+			},
+			args: args{
+				source: "header",
+				values: []string{"1", "2"},
+			},
+		},
+		{
+			name: "fail: not a number",
+			values: Values{
+				NumberArray: &NumberArrayValidator{},
+			},
+			args: args{
+				source: "cookie",
+				values: []string{"1", "two"},
+			},
+			wantErr: "cookie value is not a number [two]",
+		},
+		{
+			name: "fail: single value validator",
+			values: Values{
+				String: &StringValidator{},
+			},
+			args: args{
+				source: "header",
+				values: []string{"one"},
+			},
+			wantErr: "paramter validation must have one validation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErr(t, tt.values.ValidateValues(tt.args.source, tt.args.values), tt.wantErr)
+		})
+	}
+}
+
+func TestValues_Compile(t *testing.T) {
+	valid := "^[a-z]+$"
+	invalid := "[a-z"
+	tests := []struct {
+		name    string
+		values  Values
+		wantErr bool
+	}{
+		{
+			name: "success",
+			values: Values{
+				String: &StringValidator{RegEx: &valid},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: reg ex",
+			values: Values{
+				String: &StringValidator{RegEx: &invalid},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.values.Compile(); (err != nil) != tt.wantErr {
+				t.Errorf("Values.Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
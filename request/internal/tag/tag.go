@@ -0,0 +1,249 @@
+package tag
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Name is the struct tag key of the validation options.
+const Name = "httpx"
+
+// Options are the validation options of a struct field, for example
+//
+//	`httpx:"required,regex=^[a-z]+$,min=1,max=10,one_of=a|b,time_format=2006-01-02"`
+//
+// A comma that does not start a known option is part of the previous value,
+// so regular expressions like [0-9]{2,5} do not need to be escaped.
+type Options struct {
+	Required   bool
+	RegEx      *string
+	Min        *float64
+	Max        *float64
+	OneOf      []string
+	TimeFormat string
+	Before     *string
+	After      *string
+	Seperator  string
+}
+
+var keys = map[string]bool{
+	"required":    false,
+	"regex":       true,
+	"min":         true,
+	"max":         true,
+	"one_of":      true,
+	"time_format": true,
+	"before":      true,
+	"after":       true,
+	"seperator":   true,
+}
+
+// Parse returns the options of the tag value.
+func Parse(tag string) (Options, error) {
+	options := Options{}
+	if len(tag) == 0 {
+		return options, nil
+	}
+	parts := []string{}
+	for _, part := range strings.Split(tag, ",") {
+		key, _, _ := strings.Cut(part, "=")
+		if _, has := keys[key]; has || len(parts) == 0 {
+			parts = append(parts, part)
+			continue
+		}
+		parts[len(parts)-1] += "," + part
+	}
+
+	for _, part := range parts {
+		key, value, hasValue := strings.Cut(part, "=")
+		needsValue, has := keys[key]
+		switch {
+		case !has:
+			return Options{}, fmt.Errorf("tag option [%s] is not supported", key)
+		case needsValue && !hasValue:
+			return Options{}, fmt.Errorf("tag option [%s] requires a value", key)
+		case !needsValue && hasValue:
+			return Options{}, fmt.Errorf("tag option [%s] does not have a value", key)
+		}
+		switch key {
+		case "required":
+			options.Required = true
+		case "regex":
+			options.RegEx = &value
+		case "min":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Options{}, fmt.Errorf("tag option [min] is not a number: %w", err)
+			}
+			options.Min = &f
+		case "max":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Options{}, fmt.Errorf("tag option [max] is not a number: %w", err)
+			}
+			options.Max = &f
+		case "one_of":
+			options.OneOf = strings.Split(value, "|")
+		case "time_format":
+			options.TimeFormat = value
+		case "before":
+			options.Before = &value
+		case "after":
+			options.After = &value
+		case "seperator":
+			options.Seperator = value
+		}
+	}
+	return options, nil
+}
+
+// Float64s returns the one of options as numbers.
+func (o Options) Float64s() ([]float64, error) {
+	if len(o.OneOf) == 0 {
+		return nil, nil
+	}
+	nums := make([]float64, len(o.OneOf))
+	for i, s := range o.OneOf {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("tag option [one_of] value [%s] is not a number: %w", s, err)
+		}
+		nums[i] = f
+	}
+	return nums, nil
+}
+
+// Format returns the time format, RFC 3339 when not present.
+func (o Options) Format() string {
+	if len(o.TimeFormat) == 0 {
+		return time.RFC3339
+	}
+	return o.TimeFormat
+}
+
+// Allowed returns an error if an option is present that the kind does not
+// support.
+func (o Options) Allowed(kind Kind) error {
+	present := map[string]bool{
+		"regex":       o.RegEx != nil,
+		"min":         o.Min != nil,
+		"max":         o.Max != nil,
+		"one_of":      len(o.OneOf) > 0,
+		"time_format": len(o.TimeFormat) > 0,
+		"before":      o.Before != nil,
+		"after":       o.After != nil,
+		"seperator":   len(o.Seperator) > 0,
+	}
+	allowed := map[string]bool{}
+	switch kind {
+	case String:
+		allowed = map[string]bool{"regex": true, "one_of": true}
+	case Number:
+		allowed = map[string]bool{"min": true, "max": true, "one_of": true}
+	case Time:
+		allowed = map[string]bool{"time_format": true, "before": true, "after": true}
+	case StringArray:
+		allowed = map[string]bool{"regex": true, "seperator": true}
+	case NumberArray:
+		allowed = map[string]bool{"min": true, "max": true, "seperator": true}
+	case TimeArray:
+		allowed = map[string]bool{"time_format": true, "before": true, "after": true, "seperator": true}
+	}
+	for _, key := range []string{"regex", "min", "max", "one_of", "time_format", "before", "after", "seperator"} {
+		if present[key] && !allowed[key] {
+			return fmt.Errorf("tag option [%s] is not supported for %s", key, kind)
+		}
+	}
+	return nil
+}
+
+type Kind int
+
+const (
+	Invalid Kind = iota
+	String
+	Number
+	Boolean
+	Time
+	Object
+	StringArray
+	NumberArray
+	TimeArray
+	ObjectArray
+)
+
+func (k Kind) String() string {
+	switch k {
+	case String:
+		return "string"
+	case Number:
+		return "number"
+	case Boolean:
+		return "boolean"
+	case Time:
+		return "time"
+	case Object:
+		return "object"
+	case StringArray:
+		return "string array"
+	case NumberArray:
+		return "number array"
+	case TimeArray:
+		return "time array"
+	case ObjectArray:
+		return "object array"
+	default:
+		return "invalid"
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// KindOf returns the validator kind of the type, pointers are dereferenced.
+func KindOf(t reflect.Type) Kind {
+	t = Indirect(t)
+	switch {
+	case t == timeType:
+		return Time
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8:
+		return Invalid
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		switch KindOf(t.Elem()) {
+		case String:
+			return StringArray
+		case Number:
+			return NumberArray
+		case Time:
+			return TimeArray
+		case Object:
+			return ObjectArray
+		default:
+			return Invalid
+		}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return String
+	case reflect.Bool:
+		return Boolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return Number
+	case reflect.Struct:
+		return Object
+	default:
+		return Invalid
+	}
+}
+
+// Indirect returns the type that the pointer type points to.
+func Indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,112 @@
+package tag
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	type args struct {
+		tag string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Options
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				tag: "required,regex=^[0-9]{2,5}$,min=1,max=10,one_of=a|b,time_format=2006-01-02,seperator=,",
+			},
+			want: Options{
+				Required: true,
+				RegEx: func() *string {
+					s := "^[0-9]{2,5}$"
+					return &s
+				}(),
+				Min: func() *float64 {
+					f := 1.0
+					return &f
+				}(),
+				Max: func() *float64 {
+					f := 10.0
+					return &f
+				}(),
+				OneOf:      []string{"a", "b"},
+				TimeFormat: "2006-01-02",
+				Seperator:  ",",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: empty",
+			args: args{
+				tag: "",
+			},
+			want:    Options{},
+			wantErr: false,
+		},
+		{
+			name: "fail: unknown option",
+			args: args{
+				tag: "optional",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: min",
+			args: args{
+				tag: "min=one",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: required value",
+			args: args{
+				tag: "required=true",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.args.tag)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	type object struct{}
+	tests := []struct {
+		name string
+		t    reflect.Type
+		want Kind
+	}{
+		{name: "string", t: reflect.TypeOf(""), want: String},
+		{name: "number", t: reflect.TypeOf(uint8(0)), want: Number},
+		{name: "boolean pointer", t: reflect.TypeOf(new(bool)), want: Boolean},
+		{name: "time", t: reflect.TypeOf(time.Time{}), want: Time},
+		{name: "object", t: reflect.TypeOf(object{}), want: Object},
+		{name: "time array", t: reflect.TypeOf([]time.Time{}), want: TimeArray},
+		{name: "object array", t: reflect.TypeOf([]*object{}), want: ObjectArray},
+		{name: "bytes", t: reflect.TypeOf([]byte{}), want: Invalid},
+		{name: "map", t: reflect.TypeOf(map[string]string{}), want: Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KindOf(tt.t); got != tt.want {
+				t.Errorf("KindOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
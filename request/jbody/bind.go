@@ -0,0 +1,37 @@
+package jbody
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Bind validates the request JSON body with the schema and then decodes it
+// into T with the json tags.  A schema error is returned when the validation
+// fails and a bind error when the body can not be decoded into T.
+func Bind[T any](schema Schema, req *http.Request) (T, error) {
+	var value T
+	if err := schema.Validate(req); err != nil {
+		return value, err
+	}
+	body, ok := req.Body.(*decodedBody)
+	if !ok {
+		return value, &rerror.BindErr{
+			Msg: "request json body bind: decoded body not present",
+		}
+	}
+	if err := json.Unmarshal(body.raw, &value); err != nil {
+		bindErr := &rerror.BindErr{
+			Msg: "request json body bind",
+			Err: err.Error(),
+		}
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			bindErr.Field = typeErr.Field
+		}
+		return value, bindErr
+	}
+	return value, nil
+}
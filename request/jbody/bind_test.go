@@ -0,0 +1,100 @@
+package jbody
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testBindSchemaJSON = `{
+	"title": "create user",
+	"body": {
+		"object": {
+			"required_fields": {"one_of": [["name"]]},
+			"parameters": {
+				"name": {"validation": {"string_validator": {}}},
+				"age": {"validation": {"number_validator": {"min": 1}}},
+				"address": {
+					"validation": {
+						"object_validator": {
+							"parameters": {
+								"zip": {"validation": {"string_validator": {}}}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+type testBindAddress struct {
+	Zip string `json:"zip"`
+}
+
+type testBindUser struct {
+	Name    string           `json:"name"`
+	Age     int              `json:"age"`
+	Address *testBindAddress `json:"address"`
+}
+
+func TestBind(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testBindSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	type args struct {
+		body string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    testBindUser
+		wantErr error
+	}{
+		{
+			name: "success",
+			args: args{
+				body: `{"name": "Gary", "age": 34, "address": {"zip": "12345"}}`,
+			},
+			want: testBindUser{
+				Name: "Gary",
+				Age:  34,
+				Address: &testBindAddress{
+					Zip: "12345",
+				},
+			},
+		},
+		{
+			name: "fail: validation",
+			args: args{
+				body: `{"age": 34}`,
+			},
+			wantErr: &rerror.SchemaErr{},
+		},
+		{
+			name: "fail: bind",
+			args: args{
+				body: `{"name": "Gary", "age": 34.5}`,
+			},
+			wantErr: &rerror.BindErr{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com/users", strings.NewReader(tt.args.body))
+			got, err := Bind[testBindUser](schema, req)
+			if (err != nil || tt.wantErr != nil) && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Bind() error = %v, wantErr %T", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bind() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
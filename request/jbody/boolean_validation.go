@@ -18,3 +18,9 @@ func (b BooleanValidator) Validate(value any) error {
 		return fmt.Errorf("value is not a boolean [%T]", value)
 	}
 }
+
+// Compile returns a copy of the validator.
+func (b BooleanValidator) Compile() (BooleanValidator, error) {
+	v, err := b.BooleanValidator.Compile()
+	return BooleanValidator{BooleanValidator: v}, err
+}
@@ -0,0 +1,107 @@
+package jbody
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	if s.Limits != nil {
+		limits := *s.Limits
+		compiled.Limits = &limits
+	}
+	var issues []rerror.LintIssue
+	switch {
+	case s.Body.Object != nil && s.Body.ObjectArray != nil:
+		issues = append(issues, rerror.LintIssue{
+			Path: "/body",
+			Msg:  "body validation can not be an object AND object array",
+		})
+	case s.Body.Object != nil:
+		obj := s.Body.Object.compile("/body/object", &issues)
+		compiled.Body.Object = &obj
+	case s.Body.ObjectArray != nil:
+		obj := s.Body.ObjectArray.Object.compile("/body/object_array/object", &issues)
+		compiled.Body.ObjectArray = &ObjectArrayValidator{Object: obj}
+	}
+	if err := rerror.LintFromIssues("json body schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate decodes and validates the request JSON body, the same as
+// Schema.Validate.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
+
+// ValidateBody validates a decoded JSON body.
+func (c *CompiledSchema) ValidateBody(body any) error {
+	return c.schema.Body.Validate(body)
+}
+
+func (o ObjectValidator) compile(path string, issues *[]rerror.LintIssue) ObjectValidator {
+	compiled := o
+	compiled.RequiredFields = o.RequiredFields.Clone()
+	compiled.Parameters = make(map[string]ParameterProperties, len(o.Parameters))
+
+	params := make([]string, 0, len(o.Parameters))
+	for param := range o.Parameters {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+	for _, param := range params {
+		path := path + "/parameters/" + rerror.PointerToken(param) + "/validation"
+		properties := o.Parameters[param]
+		validation, err := properties.Validation.compile(path, issues)
+		if err != nil {
+			*issues = append(*issues, rerror.LintIssue{
+				Path: path,
+				Msg:  err.Error(),
+			})
+			continue
+		}
+		properties.Validation = validation
+		compiled.Parameters[param] = properties
+	}
+	return compiled
+}
+
+func (p ParameterValidation) compile(path string, issues *[]rerror.LintIssue) (ParameterValidation, error) {
+	if _, err := propertyValidator(p); err != nil {
+		return ParameterValidation{}, err
+	}
+	var err error
+	switch {
+	case p.String != nil:
+		p.String, err = parameter.Compile(p.String)
+	case p.StringArray != nil:
+		p.StringArray, err = parameter.Compile(p.StringArray)
+	case p.Number != nil:
+		p.Number, err = parameter.Compile(p.Number)
+	case p.NumberArray != nil:
+		p.NumberArray, err = parameter.Compile(p.NumberArray)
+	case p.Time != nil:
+		p.Time, err = parameter.Compile(p.Time)
+	case p.TimeArray != nil:
+		p.TimeArray, err = parameter.Compile(p.TimeArray)
+	case p.Boolean != nil:
+		p.Boolean, err = parameter.Compile(p.Boolean)
+	case p.Object != nil:
+		obj := p.Object.compile(path+"/object_validator", issues)
+		p.Object = &obj
+	case p.ObjectArray != nil:
+		obj := p.ObjectArray.Object.compile(path+"/object_array_validator/object", issues)
+		p.ObjectArray = &ObjectArrayValidator{Object: obj}
+	}
+	return p, err
+}
@@ -0,0 +1,202 @@
+package jbody
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testCompileSchemaJSON = `{
+	"body": {
+		"object": {
+			"required_fields": {"one_of": [["name", "address"]]},
+			"parameters": {
+				"name": {"validation": {"string_validator": {"regex": "^[A-Z][a-z]+$"}}},
+				"born": {"validation": {"time_validator": {"format": "2006-01-02", "after": "1900-01-01", "before": "2030-01-01"}}},
+				"address": {
+					"validation": {
+						"object_validator": {
+							"parameters": {
+								"state": {"validation": {"string_validator": {"one_of": ["CA", "NY", "TX"]}}},
+								"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}}
+							}
+						}
+					}
+				},
+				"pets": {
+					"validation": {
+						"object_array_validator": {
+							"object": {
+								"parameters": {
+									"name": {"validation": {"string_validator": {"regex": "^[a-z]+$"}}}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+const testCompileBodyJSON = `{"name": "Gary", "born": "1980-05-01", "address": {"state": "TX", "zip": "75001"}, "pets": [{"name": "rex"}, {"name": "tom"}]}`
+
+func TestSchema_Compile(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: testCompileSchemaJSON,
+			},
+			want: nil,
+		},
+		{
+			name: "fail: compile errors",
+			args: args{
+				schema: `{
+					"body": {
+						"object": {
+							"parameters": {
+								"name": {"validation": {"string_validator": {"regex": "[a-z"}}},
+								"pets": {
+									"validation": {
+										"object_array_validator": {
+											"object": {
+												"parameters": {
+													"born": {"validation": {"time_validator": {"format": "2006-01-02", "after": "01/01/1900"}}}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}`,
+			},
+			want: []string{
+				"/body/object/parameters/name/validation",
+				"/body/object/parameters/pets/validation/object_array_validator/object/parameters/born/validation",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema Schema
+			if err := json.NewDecoder(strings.NewReader(tt.args.schema)).Decode(&schema); err != nil {
+				t.Fatalf("schema decode error = %v", err)
+			}
+			compiled, err := schema.Compile()
+			if tt.want == nil {
+				if err != nil || compiled == nil {
+					t.Errorf("Schema.Compile() error = %v", err)
+				}
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("Schema.Compile() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Schema.Compile() issues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompiledSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testCompileSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			body:    testCompileBodyJSON,
+			wantErr: false,
+		},
+		{
+			name:    "fail: nested",
+			body:    `{"name": "gary", "born": "2040-01-01", "address": {"state": "WA", "zip": "7500"}, "pets": [{"name": "Rex"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com", strings.NewReader(tt.body))
+			err := compiled.Validate(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompiledSchema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			req = httptest.NewRequest(http.MethodPost, "http://www.test.com", strings.NewReader(tt.body))
+			if want := schema.Validate(req); !reflect.DeepEqual(err, want) {
+				t.Errorf("CompiledSchema.Validate() error = %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+func BenchmarkSchema_Validate(b *testing.B) {
+	schema, err := SchemaFromJSON(strings.NewReader(testCompileSchemaJSON))
+	if err != nil {
+		b.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	var body any
+	if err := json.Unmarshal([]byte(testCompileBodyJSON), &body); err != nil {
+		b.Fatalf("body decode error = %v", err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := schema.Body.Validate(body); err != nil {
+			b.Fatalf("Body.Validate() error = %v", err)
+		}
+	}
+}
+
+func BenchmarkCompiledSchema_Validate(b *testing.B) {
+	schema, err := SchemaFromJSON(strings.NewReader(testCompileSchemaJSON))
+	if err != nil {
+		b.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		b.Fatalf("Schema.Compile() error = %v", err)
+	}
+	var body any
+	if err := json.Unmarshal([]byte(testCompileBodyJSON), &body); err != nil {
+		b.Fatalf("body decode error = %v", err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := compiled.ValidateBody(body); err != nil {
+			b.Fatalf("CompiledSchema.ValidateBody() error = %v", err)
+		}
+	}
+}
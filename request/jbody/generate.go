@@ -0,0 +1,217 @@
+package jbody
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/internal/tag"
+)
+
+// SchemaFromType generates a schema from T, which is either a struct or a
+// slice of structs.  The parameter names are from the json tags and the
+// validation options from the httpx tags, see the README for the options.
+func SchemaFromType[T any]() (Schema, error) {
+	t := tag.Indirect(reflect.TypeOf((*T)(nil)).Elem())
+	g := generator{
+		types: map[reflect.Type]bool{},
+	}
+	switch tag.KindOf(t) {
+	case tag.Object:
+		obj, err := g.object(t)
+		if err != nil {
+			return Schema{}, fmt.Errorf("schema from type [%s]: %w", t, err)
+		}
+		schema := Schema{Body: Body{Object: obj}}
+		if err := Lint(schema); err != nil {
+			return Schema{}, fmt.Errorf("schema from type [%s]: %w", t, err)
+		}
+		return schema, nil
+	case tag.ObjectArray:
+		obj, err := g.object(tag.Indirect(t.Elem()))
+		if err != nil {
+			return Schema{}, fmt.Errorf("schema from type [%s]: %w", t, err)
+		}
+		schema := Schema{Body: Body{ObjectArray: &ObjectArrayValidator{Object: *obj}}}
+		if err := Lint(schema); err != nil {
+			return Schema{}, fmt.Errorf("schema from type [%s]: %w", t, err)
+		}
+		return schema, nil
+	default:
+		return Schema{}, fmt.Errorf("schema from type [%s]: type must be a struct or a slice of structs", t)
+	}
+}
+
+type generator struct {
+	types map[reflect.Type]bool
+}
+
+func (g generator) object(t reflect.Type) (*ObjectValidator, error) {
+	if g.types[t] {
+		return nil, fmt.Errorf("recursive type [%s] is not supported", t)
+	}
+	g.types[t] = true
+	defer delete(g.types, t)
+
+	obj := &ObjectValidator{
+		Parameters: map[string]ParameterProperties{},
+	}
+	if err := g.fields(t, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (g generator) fields(t reflect.Type, obj *ObjectValidator) error {
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, omit := jsonName(sf)
+		switch {
+		case omit:
+			continue
+		case sf.Anonymous && len(name) == 0 && tag.KindOf(sf.Type) == tag.Object:
+			if err := g.fields(tag.Indirect(sf.Type), obj); err != nil {
+				return err
+			}
+			continue
+		case !sf.IsExported():
+			continue
+		case len(name) == 0:
+			name = sf.Name
+		}
+		options, err := tag.Parse(sf.Tag.Get(tag.Name))
+		if err != nil {
+			return fmt.Errorf("field [%s]: %w", sf.Name, err)
+		}
+		validation, err := g.validation(sf.Type, options)
+		if err != nil {
+			return fmt.Errorf("field [%s]: %w", sf.Name, err)
+		}
+		if _, has := obj.Parameters[name]; has {
+			return fmt.Errorf("field [%s]: parameter [%s] already present", sf.Name, name)
+		}
+		obj.Parameters[name] = ParameterProperties{
+			Validation: validation,
+		}
+		if options.Required {
+			required = append(required, name)
+		}
+	}
+	if len(required) > 0 {
+		if len(obj.RequiredFields.OneOf) == 0 {
+			obj.RequiredFields.OneOf = [][]string{{}}
+		}
+		obj.RequiredFields.OneOf[0] = append(obj.RequiredFields.OneOf[0], required...)
+	}
+	return nil
+}
+
+func (g generator) validation(t reflect.Type, options tag.Options) (ParameterValidation, error) {
+	kind := tag.KindOf(t)
+	if err := options.Allowed(kind); err != nil {
+		return ParameterValidation{}, err
+	}
+	if len(options.Seperator) > 0 {
+		return ParameterValidation{}, fmt.Errorf("tag option [seperator] is not supported for json bodies")
+	}
+	switch kind {
+	case tag.String:
+		return ParameterValidation{
+			String: &StringValidator{
+				StringValidator: parameter.StringValidator{
+					RegEx: options.RegEx,
+					OneOf: options.OneOf,
+				},
+			},
+		}, nil
+	case tag.Number:
+		oneOf, err := options.Float64s()
+		if err != nil {
+			return ParameterValidation{}, err
+		}
+		return ParameterValidation{
+			Number: &NumberValidator{
+				NumberValidator: parameter.NumberValidator{
+					Min:   options.Min,
+					Max:   options.Max,
+					OneOf: oneOf,
+				},
+			},
+		}, nil
+	case tag.Boolean:
+		return ParameterValidation{
+			Boolean: &BooleanValidator{},
+		}, nil
+	case tag.Time:
+		return ParameterValidation{
+			Time: &TimeValidator{
+				TimeValidator: parameter.TimeValidator{
+					Format: options.Format(),
+					Before: options.Before,
+					After:  options.After,
+				},
+			},
+		}, nil
+	case tag.StringArray:
+		return ParameterValidation{
+			StringArray: &StringArrayValidator{
+				StringArrayValidator: parameter.StringArrayValidator{
+					RegEx: options.RegEx,
+				},
+			},
+		}, nil
+	case tag.NumberArray:
+		return ParameterValidation{
+			NumberArray: &NumberArrayValidator{
+				NumberArrayValidator: parameter.NumberArrayValidator{
+					Min: options.Min,
+					Max: options.Max,
+				},
+			},
+		}, nil
+	case tag.TimeArray:
+		return ParameterValidation{
+			TimeArray: &TimeArrayValidator{
+				TimeArrayValidator: parameter.TimeArrayValidator{
+					Format: options.Format(),
+					Before: options.Before,
+					After:  options.After,
+				},
+			},
+		}, nil
+	case tag.Object:
+		obj, err := g.object(tag.Indirect(t))
+		if err != nil {
+			return ParameterValidation{}, err
+		}
+		return ParameterValidation{
+			Object: obj,
+		}, nil
+	case tag.ObjectArray:
+		obj, err := g.object(tag.Indirect(tag.Indirect(t).Elem()))
+		if err != nil {
+			return ParameterValidation{}, err
+		}
+		return ParameterValidation{
+			ObjectArray: &ObjectArrayValidator{
+				Object: *obj,
+			},
+		}, nil
+	default:
+		return ParameterValidation{}, fmt.Errorf("type [%s] is not supported", t)
+	}
+}
+
+func jsonName(sf reflect.StructField) (string, bool) {
+	value, has := sf.Tag.Lookup("json")
+	if !has {
+		return "", false
+	}
+	name, _, _ := strings.Cut(value, ",")
+	if name == "-" && !strings.Contains(value, ",") {
+		return "", true
+	}
+	return name, false
+}
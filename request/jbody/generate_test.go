@@ -0,0 +1,107 @@
+package jbody
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testGenerateAddress struct {
+	Street string `json:"street" httpx:"required"`
+	Zip    string `json:"zip" httpx:"required,regex=^[0-9]{5}$"`
+}
+
+type testGenerateAudit struct {
+	Created time.Time `json:"created" httpx:"time_format=2006-01-02"`
+}
+
+type testGenerateUser struct {
+	testGenerateAudit
+	Name      string                `json:"name" httpx:"required"`
+	Age       *int                  `json:"age" httpx:"min=1,max=150"`
+	Role      string                `json:"role" httpx:"one_of=admin|user"`
+	Married   bool                  `json:"married"`
+	Tags      []string              `json:"tags" httpx:"regex=^[a-z]+$"`
+	Address   testGenerateAddress   `json:"address"`
+	Others    []testGenerateAddress `json:"other_addresses"`
+	Ignored   string                `json:"-"`
+	unexposed string
+}
+
+type testGenerateNode struct {
+	Name     string              `json:"name"`
+	Children []*testGenerateNode `json:"children"`
+}
+
+type testGenerateInvalid struct {
+	Name string `json:"name" httpx:"min=1"`
+}
+
+func TestSchemaFromType(t *testing.T) {
+	schema, err := SchemaFromType[testGenerateUser]()
+	if err != nil {
+		t.Fatalf("SchemaFromType() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			body:    `{"name": "Gary", "age": 34, "role": "admin", "married": false, "tags": ["one"], "created": "2020-01-02", "address": {"street": "Main", "zip": "12345"}, "other_addresses": [{"street": "Side", "zip": "54321"}]}`,
+			wantErr: false,
+		},
+		{
+			name:    "fail: required",
+			body:    `{"age": 34}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: nested regex",
+			body:    `{"name": "Gary", "other_addresses": [{"street": "Side", "zip": "5432"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: one of",
+			body:    `{"name": "Gary", "role": "owner"}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: time format",
+			body:    `{"name": "Gary", "created": "2020-01-02T00:00:00Z"}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: ignored field",
+			body:    `{"name": "Gary", "Ignored": "value"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com/users", strings.NewReader(tt.body))
+			if err := schema.Validate(req); (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaFromType_Failure(t *testing.T) {
+	if _, err := SchemaFromType[testGenerateNode](); err == nil {
+		t.Errorf("SchemaFromType() expected a recursive type error")
+	}
+	if _, err := SchemaFromType[testGenerateInvalid](); err == nil {
+		t.Errorf("SchemaFromType() expected a tag option error")
+	}
+	if _, err := SchemaFromType[string](); err == nil {
+		t.Errorf("SchemaFromType() expected a type error")
+	}
+	schema, err := SchemaFromType[[]testGenerateAddress]()
+	if err != nil || schema.Body.ObjectArray == nil {
+		t.Errorf("SchemaFromType() object array = %v, error = %v", schema.Body.ObjectArray, err)
+	}
+}
@@ -0,0 +1,97 @@
+package jbody
+
+import (
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Lint returns an error with all of the schema rules that contradict each
+// other, or can never be satisfied.
+func Lint(schema Schema) error {
+	var issues []rerror.LintIssue
+	switch {
+	case schema.Body.Object != nil && schema.Body.ObjectArray != nil:
+		issues = append(issues, rerror.LintIssue{
+			Path: "/body",
+			Msg:  "body validation can not be an object AND object array",
+		})
+	case schema.Body.Object != nil:
+		issues = schema.Body.Object.lint("/body/object")
+	case schema.Body.ObjectArray != nil:
+		issues = schema.Body.ObjectArray.Object.lint("/body/object_array/object")
+	}
+	if schema.Limits != nil {
+		issues = append(issues, schema.Limits.lint("/limits")...)
+	}
+	return rerror.LintFromIssues("json body schema lint", issues)
+}
+
+func (o ObjectValidator) lint(path string) []rerror.LintIssue {
+	issues := o.RequiredFields.Lint(path+"/required_fields", field.Set(o.Parameters))
+	if o.MaxErrors < 0 {
+		issues = append(issues, rerror.LintIssue{
+			Path: path + "/max_errors",
+			Msg:  "max errors can not be negative",
+		})
+	}
+
+	params := make([]string, 0, len(o.Parameters))
+	for param := range o.Parameters {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+	for _, param := range params {
+		path := path + "/parameters/" + rerror.PointerToken(param) + "/validation"
+		v := o.Parameters[param].Validation
+		if _, err := propertyValidator(v); err != nil {
+			issues = append(issues, rerror.LintIssue{
+				Path: path,
+				Msg:  err.Error(),
+			})
+			continue
+		}
+		switch {
+		case v.String != nil:
+			issues = append(issues, v.String.Lint(path+"/string_validator")...)
+		case v.StringArray != nil:
+			issues = append(issues, v.StringArray.Lint(path+"/string_array_validator")...)
+		case v.Number != nil:
+			issues = append(issues, v.Number.Lint(path+"/number_validator")...)
+		case v.NumberArray != nil:
+			issues = append(issues, v.NumberArray.Lint(path+"/number_array_validator")...)
+		case v.Time != nil:
+			issues = append(issues, v.Time.Lint(path+"/time_validator")...)
+		case v.TimeArray != nil:
+			issues = append(issues, v.TimeArray.Lint(path+"/time_array_validator")...)
+		case v.Object != nil:
+			issues = append(issues, v.Object.lint(path+"/object_validator")...)
+		case v.ObjectArray != nil:
+			issues = append(issues, v.ObjectArray.Object.lint(path+"/object_array_validator/object")...)
+		}
+	}
+	return issues
+}
+
+func (l Limits) lint(path string) []rerror.LintIssue {
+	var issues []rerror.LintIssue
+	limits := []struct {
+		name  string
+		value int64
+	}{
+		{name: "max_bytes", value: l.MaxBytes},
+		{name: "max_depth", value: int64(l.MaxDepth)},
+		{name: "max_array_length", value: int64(l.MaxArrayLength)},
+		{name: "max_string_length", value: int64(l.MaxStringLength)},
+	}
+	for _, limit := range limits {
+		if limit.value < 0 {
+			issues = append(issues, rerror.LintIssue{
+				Path: path + "/" + limit.name,
+				Msg:  "limit can not be negative",
+			})
+		}
+	}
+	return issues
+}
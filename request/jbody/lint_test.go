@@ -0,0 +1,120 @@
+package jbody
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestSchemaFromJSON_Lint(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: `{
+					"body": {
+						"object": {
+							"required_fields": {"one_of": [["name"]]},
+							"parameters": {
+								"name": {"validation": {"string_validator": {"value": "Gary", "regex": "^[A-Z]"}}}
+							}
+						}
+					}
+				}`,
+			},
+			want: nil,
+		},
+		{
+			name: "fail: contradictions",
+			args: args{
+				schema: `{
+					"body": {
+						"object": {
+							"required_fields": {"one_of": [["name", "age"]]},
+							"parameters": {
+								"name": {"validation": {"string_validator": {"value": "gary", "regex": "^[A-Z]"}}},
+								"address": {
+									"validation": {
+										"object_validator": {
+											"parameters": {
+												"zip": {"validation": {}}
+											}
+										}
+									}
+								},
+								"pets": {
+									"validation": {
+										"object_array_validator": {
+											"object": {
+												"parameters": {
+													"born": {"validation": {"time_validator": {"format": "2006", "before": "2000", "after": "2010"}}}
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}`,
+			},
+			want: []string{
+				"/body/object/required_fields/one_of/0/1",
+				"/body/object/parameters/address/validation/object_validator/parameters/zip/validation",
+				"/body/object/parameters/name/validation/string_validator/value",
+				"/body/object/parameters/pets/validation/object_array_validator/object/parameters/born/validation/time_validator/before",
+			},
+		},
+		{
+			name: "fail: negative limits",
+			args: args{
+				schema: `{
+					"body": {
+						"object": {
+							"parameters": {
+								"name": {"validation": {"string_validator": {}}}
+							}
+						}
+					},
+					"limits": {"max_bytes": 1024, "max_depth": -1, "max_string_length": -1}
+				}`,
+			},
+			want: []string{
+				"/limits/max_depth",
+				"/limits/max_string_length",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromJSON(strings.NewReader(tt.args.schema))
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("SchemaFromJSON() error = %v", err)
+				}
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("SchemaFromJSON() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SchemaFromJSON() lint paths = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
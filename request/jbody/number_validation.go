@@ -41,3 +41,15 @@ func (n NumberArrayValidator) Validate(value any) error {
 	}
 	return n.NumberArrayValidator.Validate(numArr)
 }
+
+// Compile returns a copy of the validator with the one of values in a set.
+func (n NumberValidator) Compile() (NumberValidator, error) {
+	v, err := n.NumberValidator.Compile()
+	return NumberValidator{NumberValidator: v}, err
+}
+
+// Compile returns a copy of the validator.
+func (n NumberArrayValidator) Compile() (NumberArrayValidator, error) {
+	v, err := n.NumberArrayValidator.Compile()
+	return NumberArrayValidator{NumberArrayValidator: v}, err
+}
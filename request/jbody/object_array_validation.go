@@ -24,10 +24,33 @@ func (o ObjectArrayValidator) Validate(value any) error {
 	default:
 		return fmt.Errorf("value is not an object array [%T]", value)
 	}
+	c := newCollector(o.Object.MaxErrors)
 	for i, obj := range objs {
-		if err := o.Object.Validate(obj); err != nil {
-			return fmt.Errorf("object array error idx [%d]: %w", i, err)
+		if c.full() {
+			break
 		}
+		o.Object.collect(location{}.index(i), obj, c)
+	}
+	return c.result()
+}
+
+func (o ObjectArrayValidator) collect(loc location, value any, c *collector) {
+	var objs []any
+	switch v := value.(type) {
+	case []any:
+		objs = v
+	case []map[string]any:
+		for _, obj := range v {
+			objs = append(objs, obj)
+		}
+	default:
+		c.add(loc, fmt.Sprintf("value is not an object array [%T]", value))
+		return
+	}
+	for i, obj := range objs {
+		if c.full() {
+			return
+		}
+		o.Object.collect(loc.index(i), obj, c)
 	}
-	return nil
 }
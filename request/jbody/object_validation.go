@@ -3,6 +3,8 @@ package jbody
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/g8rswimmer/httpx/request/internal/field"
 	"github.com/g8rswimmer/httpx/request/rerror"
@@ -12,49 +14,175 @@ type validator interface {
 	Validate(value any) error
 }
 
+// ObjectValidator validates a JSON object.  The required and unknown fields
+// of the object are returned as a field error.  The parameter failures,
+// including the required and unknown fields of nested objects and object
+// arrays, are collected in a single parameter error with the JSON Pointer of
+// each failure.  MaxErrors caps the number of failures collected, zero
+// collects all of them.  The cap of the outer most validator is used.
 type ObjectValidator struct {
 	RequiredFields field.Required                 `json:"required_fields"`
 	Parameters     map[string]ParameterProperties `json:"parameters"`
+	MaxErrors      int                            `json:"max_errors"`
 }
 
 func (o ObjectValidator) Validate(value any) error {
-	var obj map[string]any
-	switch v := value.(type) {
-	case map[string]any:
-		obj = v
-	default:
+	obj, ok := value.(map[string]any)
+	if !ok {
 		return fmt.Errorf("value is not an object [%T]", value)
 	}
+	if err := o.validateFields(obj); err != nil {
+		return err
+	}
+	c := newCollector(o.MaxErrors)
+	o.validateParameters(location{}, obj, c)
+	return c.result()
+}
 
+// validateFields returns the required and unknown field failures of the
+// object in one field error.
+func (o ObjectValidator) validateFields(obj map[string]any) error {
 	fields := field.Set(obj)
 
+	fieldErr := &rerror.FieldErr{}
+	msgs := []string{}
 	if err := o.RequiredFields.Validate(fields); err != nil {
-		return err
+		var requiredErr *rerror.FieldErr
+		if !errors.As(err, &requiredErr) {
+			return err
+		}
+		fieldErr.OneOf = requiredErr.OneOf
+		fieldErr.Present = requiredErr.Present
+		msgs = append(msgs, requiredErr.Msg)
 	}
-
 	if err := field.Validate(fields, o.Parameters); err != nil {
-		return err
+		var unknownErr *rerror.FieldErr
+		if !errors.As(err, &unknownErr) {
+			return err
+		}
+		fieldErr.Unknown = unknownErr.Unknown
+		sort.Strings(fieldErr.Unknown)
+		msgs = append(msgs, unknownErr.Msg)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	fieldErr.Msg = strings.Join(msgs, ", ")
+	return fieldErr
+}
+
+func (o ObjectValidator) collect(loc location, value any, c *collector) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		c.add(loc, fmt.Sprintf("value is not an object [%T]", value))
+		return
 	}
 
-	for field, value := range obj {
-		properties := o.Parameters[field]
+	fields := field.Set(obj)
+
+	if err := o.RequiredFields.Validate(fields); err != nil {
+		c.add(loc, err.Error())
+	}
+	o.validateParameters(loc, obj, c)
+}
+
+func (o ObjectValidator) validateParameters(loc location, obj map[string]any, c *collector) {
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if c.full() {
+			return
+		}
+		key := loc.field(name)
+		properties, has := o.Parameters[name]
+		if !has {
+			c.add(key, "unknown field")
+			continue
+		}
 		val, err := propertyValidator(properties.Validation)
 		if err != nil {
-			return &rerror.ParameterErr{
-				Parameters: map[string]string{
-					field: fmt.Sprintf("object validator: %v", err),
-				},
-			}
+			c.add(key, fmt.Sprintf("object validator: %v", err))
+			continue
 		}
-		if err := val.Validate(value); err != nil {
-			return &rerror.ParameterErr{
-				Parameters: map[string]string{
-					field: err.Error(),
-				},
+		switch v := val.(type) {
+		case *ObjectValidator:
+			v.collect(key, obj[name], c)
+		case *ObjectArrayValidator:
+			v.collect(key, obj[name], c)
+		default:
+			if err := val.Validate(obj[name]); err != nil {
+				c.add(key, err.Error())
 			}
 		}
 	}
-	return nil
+}
+
+// location is where a value is in the body, key is the parameter error key
+// and pointer is the JSON Pointer.
+type location struct {
+	key     string
+	pointer string
+}
+
+func (l location) field(name string) location {
+	key := name
+	if len(l.key) > 0 {
+		key = l.key + "." + name
+	}
+	return location{
+		key:     key,
+		pointer: l.pointer + "/" + rerror.PointerToken(name),
+	}
+}
+
+func (l location) index(idx int) location {
+	return location{
+		key:     fmt.Sprintf("%s[%d]", l.key, idx),
+		pointer: fmt.Sprintf("%s/%d", l.pointer, idx),
+	}
+}
+
+type collector struct {
+	err *rerror.ParameterErr
+	max int
+}
+
+func newCollector(max int) *collector {
+	return &collector{
+		err: &rerror.ParameterErr{
+			Parameters: map[string]string{},
+		},
+		max: max,
+	}
+}
+
+func (c *collector) add(loc location, msg string) {
+	if c.full() {
+		return
+	}
+	key := loc.key
+	if _, has := c.err.Parameters[key]; has {
+		// a dotted field name can collide with a nested field, the pointer
+		// keeps both of the failures.
+		key = loc.pointer
+	}
+	c.err.Add(key, msg)
+	c.err.AddViolation(loc.pointer, msg)
+}
+
+func (c *collector) full() bool {
+	return c.max > 0 && len(c.err.Violations) >= c.max
+}
+
+func (c *collector) result() error {
+	if !c.err.Has() {
+		return nil
+	}
+	return c.err
 }
 
 func propertyValidator(validation ParameterValidation) (validator, error) {
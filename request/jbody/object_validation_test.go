@@ -2,10 +2,13 @@ package jbody
 
 import (
 	"encoding/json"
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/g8rswimmer/httpx/request/internal/field"
 	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
 )
 
 func TestObjectValidator_Validate_Success(t *testing.T) {
@@ -494,3 +497,270 @@ func TestObjectValidator_Validate_Failure(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectValidator_Validate_Collect(t *testing.T) {
+	const schema = `{
+		"parameters": {
+			"name": {"validation": {"string_validator": {"regex": "^[A-Z][a-z]+$"}}},
+			"age": {"validation": {"number_validator": {"min": 18}}},
+			"address": {
+				"validation": {
+					"object_validator": {
+						"required_fields": {"one_of": [["zip"]]},
+						"parameters": {
+							"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}},
+							"state": {"validation": {"string_validator": {"regex": "^[A-Z]{2}$"}}}
+						}
+					}
+				}
+			},
+			"pets": {
+				"validation": {
+					"object_array_validator": {
+						"object": {
+							"parameters": {
+								"name": {"validation": {"string_validator": {}}}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+	const body = `{
+		"name": "gary",
+		"age": 10,
+		"address": {"state": "Illinois", "a/b": 1},
+		"pets": [{"name": "Spot"}, {"name": 5}, {"kind": "cat"}]
+	}`
+	type args struct {
+		maxErrors int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           map[string]string
+		wantViolations []rerror.Violation
+	}{
+		{
+			name: "all",
+			args: args{},
+			want: map[string]string{
+				"address":       "one of the field combinations are requried",
+				"address.a/b":   "unknown field",
+				"address.state": "value [Illinois] does not match reg exp ^[A-Z]{2}$",
+				"age":           "value [10.000000] is less than 18.000000",
+				"name":          "value [gary] does not match reg exp ^[A-Z][a-z]+$",
+				"pets[1].name":  "value is not a string [float64]",
+				"pets[2].kind":  "unknown field",
+			},
+			wantViolations: []rerror.Violation{
+				{Pointer: "/address", Msg: "one of the field combinations are requried"},
+				{Pointer: "/address/a~1b", Msg: "unknown field"},
+				{Pointer: "/address/state", Msg: "value [Illinois] does not match reg exp ^[A-Z]{2}$"},
+				{Pointer: "/age", Msg: "value [10.000000] is less than 18.000000"},
+				{Pointer: "/name", Msg: "value [gary] does not match reg exp ^[A-Z][a-z]+$"},
+				{Pointer: "/pets/1/name", Msg: "value is not a string [float64]"},
+				{Pointer: "/pets/2/kind", Msg: "unknown field"},
+			},
+		},
+		{
+			name: "max errors",
+			args: args{
+				maxErrors: 3,
+			},
+			want: map[string]string{
+				"address":       "one of the field combinations are requried",
+				"address.a/b":   "unknown field",
+				"address.state": "value [Illinois] does not match reg exp ^[A-Z]{2}$",
+			},
+			wantViolations: []rerror.Violation{
+				{Pointer: "/address", Msg: "one of the field combinations are requried"},
+				{Pointer: "/address/a~1b", Msg: "unknown field"},
+				{Pointer: "/address/state", Msg: "value [Illinois] does not match reg exp ^[A-Z]{2}$"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o ObjectValidator
+			if err := json.Unmarshal([]byte(schema), &o); err != nil {
+				t.Fatalf("json.Unmarshal() schema error = %v", err)
+			}
+			o.MaxErrors = tt.args.maxErrors
+			var value any
+			if err := json.Unmarshal([]byte(body), &value); err != nil {
+				t.Fatalf("json.Unmarshal() body error = %v", err)
+			}
+			err := o.Validate(value)
+			var paramErr *rerror.ParameterErr
+			if !errors.As(err, &paramErr) {
+				t.Fatalf("ObjectValidator.Validate() error = %v, want a parameter error", err)
+			}
+			if !reflect.DeepEqual(paramErr.Parameters, tt.want) {
+				t.Errorf("ObjectValidator.Validate() parameters = %v, want %v", paramErr.Parameters, tt.want)
+			}
+			if !reflect.DeepEqual(paramErr.Violations, tt.wantViolations) {
+				t.Errorf("ObjectValidator.Validate() violations = %v, want %v", paramErr.Violations, tt.wantViolations)
+			}
+		})
+	}
+}
+
+func TestObjectValidator_Validate_Collect_Collision(t *testing.T) {
+	const schema = `{
+		"parameters": {
+			"a.b": {"validation": {"string_validator": {"regex": "^[0-9]+$"}}},
+			"a": {
+				"validation": {
+					"object_validator": {
+						"parameters": {
+							"b": {"validation": {"string_validator": {"regex": "^[0-9]+$"}}}
+						}
+					}
+				}
+			}
+		}
+	}`
+	const body = `{"a.b": "x", "a": {"b": "y"}}`
+	type args struct {
+		maxErrors int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           map[string]string
+		wantViolations []rerror.Violation
+	}{
+		{
+			name: "all",
+			args: args{},
+			want: map[string]string{
+				"a.b":  "value [y] does not match reg exp ^[0-9]+$",
+				"/a.b": "value [x] does not match reg exp ^[0-9]+$",
+			},
+			wantViolations: []rerror.Violation{
+				{Pointer: "/a/b", Msg: "value [y] does not match reg exp ^[0-9]+$"},
+				{Pointer: "/a.b", Msg: "value [x] does not match reg exp ^[0-9]+$"},
+			},
+		},
+		{
+			name: "max errors",
+			args: args{
+				maxErrors: 1,
+			},
+			want: map[string]string{
+				"a.b": "value [y] does not match reg exp ^[0-9]+$",
+			},
+			wantViolations: []rerror.Violation{
+				{Pointer: "/a/b", Msg: "value [y] does not match reg exp ^[0-9]+$"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o ObjectValidator
+			if err := json.Unmarshal([]byte(schema), &o); err != nil {
+				t.Fatalf("json.Unmarshal() schema error = %v", err)
+			}
+			o.MaxErrors = tt.args.maxErrors
+			var value any
+			if err := json.Unmarshal([]byte(body), &value); err != nil {
+				t.Fatalf("json.Unmarshal() body error = %v", err)
+			}
+			err := o.Validate(value)
+			var paramErr *rerror.ParameterErr
+			if !errors.As(err, &paramErr) {
+				t.Fatalf("ObjectValidator.Validate() error = %v, want a parameter error", err)
+			}
+			if !reflect.DeepEqual(paramErr.Parameters, tt.want) {
+				t.Errorf("ObjectValidator.Validate() parameters = %v, want %v", paramErr.Parameters, tt.want)
+			}
+			if !reflect.DeepEqual(paramErr.Violations, tt.wantViolations) {
+				t.Errorf("ObjectValidator.Validate() violations = %v, want %v", paramErr.Violations, tt.wantViolations)
+			}
+		})
+	}
+}
+
+func TestObjectValidator_Validate_Fields(t *testing.T) {
+	const schema = `{
+		"required_fields": {"one_of": [["name", "email"]]},
+		"parameters": {
+			"name": {"validation": {"string_validator": {"regex": "^[A-Z][a-z]+$"}}},
+			"email": {"validation": {"string_validator": {}}},
+			"age": {"validation": {"number_validator": {"min": 18}}}
+		}
+	}`
+	type args struct {
+		body string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantFieldErr  *rerror.FieldErr
+		wantParameter map[string]string
+	}{
+		{
+			name: "required and unknown",
+			args: args{
+				body: `{"name": "gary", "age": 10, "nickname": "g", "alias": "g"}`,
+			},
+			wantFieldErr: &rerror.FieldErr{
+				Msg:     "one of the field combinations are requried, unknown fields are present",
+				OneOf:   [][]string{{"name", "email"}},
+				Unknown: []string{"alias", "nickname"},
+			},
+		},
+		{
+			name: "unknown",
+			args: args{
+				body: `{"name": "gary", "email": "g@example.com", "nickname": "g"}`,
+			},
+			wantFieldErr: &rerror.FieldErr{
+				Msg:     "unknown fields are present",
+				Unknown: []string{"nickname"},
+			},
+		},
+		{
+			name: "parameters",
+			args: args{
+				body: `{"name": "gary", "email": "g@example.com", "age": 10}`,
+			},
+			wantParameter: map[string]string{
+				"age":  "value [10.000000] is less than 18.000000",
+				"name": "value [gary] does not match reg exp ^[A-Z][a-z]+$",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o ObjectValidator
+			if err := json.Unmarshal([]byte(schema), &o); err != nil {
+				t.Fatalf("json.Unmarshal() schema error = %v", err)
+			}
+			var value any
+			if err := json.Unmarshal([]byte(tt.args.body), &value); err != nil {
+				t.Fatalf("json.Unmarshal() body error = %v", err)
+			}
+			err := o.Validate(value)
+			if tt.wantFieldErr != nil {
+				var fieldErr *rerror.FieldErr
+				if !errors.As(err, &fieldErr) {
+					t.Fatalf("ObjectValidator.Validate() error = %v, want a field error", err)
+				}
+				if !reflect.DeepEqual(fieldErr, tt.wantFieldErr) {
+					t.Errorf("ObjectValidator.Validate() field error = %+v, want %+v", fieldErr, tt.wantFieldErr)
+				}
+				return
+			}
+			var paramErr *rerror.ParameterErr
+			if !errors.As(err, &paramErr) {
+				t.Fatalf("ObjectValidator.Validate() error = %v, want a parameter error", err)
+			}
+			if !reflect.DeepEqual(paramErr.Parameters, tt.wantParameter) {
+				t.Errorf("ObjectValidator.Validate() parameters = %v, want %v", paramErr.Parameters, tt.wantParameter)
+			}
+		})
+	}
+}
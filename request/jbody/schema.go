@@ -1,24 +1,31 @@
 package jbody
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 
+	"github.com/g8rswimmer/httpx/internal/schemaref"
 	"github.com/g8rswimmer/httpx/request/rerror"
 )
 
 type Schema struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Body        Body   `json:"body"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Body        Body    `json:"body"`
+	Limits      *Limits `json:"limits"`
 }
 
+// Validate decodes and validates the request JSON body.  The request body is
+// replaced so it can be read again, and the decoded body is available with
+// Value.  When the schema has limits the body is decoded with them.
 func (s Schema) Validate(req *http.Request) error {
-	var body any
-	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
-		return rerror.SchemaFromError("request json body validation", fmt.Errorf("schema body json decode: %w", err))
+	body, err := s.decode(req)
+	if err != nil {
+		return rerror.SchemaFromError("request json body validation", err)
 	}
 	if err := s.Body.Validate(body); err != nil {
 		return rerror.SchemaFromError("request json body validation", err)
@@ -26,10 +33,91 @@ func (s Schema) Validate(req *http.Request) error {
 	return nil
 }
 
+// Value returns the request JSON body decoded by the schema validation.
+func Value(req *http.Request) (any, bool) {
+	body, ok := req.Body.(*decodedBody)
+	if !ok {
+		return nil, false
+	}
+	return body.value, true
+}
+
+func (s Schema) decode(req *http.Request) (any, error) {
+	if s.Limits != nil {
+		return decodeStream(req, *s.Limits)
+	}
+	return decode(req)
+}
+
+type decodedBody struct {
+	*bytes.Reader
+	raw   []byte
+	value any
+}
+
+func (decodedBody) Close() error {
+	return nil
+}
+
+func decode(req *http.Request) (any, error) {
+	var raw []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("schema body read: %w", err)
+		}
+		raw = b
+	}
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+
+	var body any
+	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("schema body json decode: %w", err)
+	}
+	req.Body = &decodedBody{
+		Reader: bytes.NewReader(raw),
+		raw:    raw,
+		value:  body,
+	}
+	return body, nil
+}
+
 func SchemaFromJSON(reader io.Reader) (Schema, error) {
 	var schema Schema
-	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+	if err := schemaref.Decode(reader, &schema); err != nil {
 		return Schema{}, fmt.Errorf("schema decode json: %w", err)
 	}
+	if err := Lint(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+// SchemaFromYAML decodes the schema from YAML, with the same field names as
+// the JSON schema, and the errors have the line and column of the value.
+func SchemaFromYAML(reader io.Reader) (Schema, error) {
+	var schema Schema
+	doc, err := schemaref.DecodeYAML(reader, &schema)
+	if err != nil {
+		return Schema{}, fmt.Errorf("schema decode yaml: %w", err)
+	}
+	if err := Lint(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", doc.Locate(err))
+	}
+	return schema, nil
+}
+
+// SchemaFromFS decodes the schema file of the file system, the references to
+// other files are relative to the file.  A file with a .yaml or .yml
+// extension is YAML and any other file is JSON.
+func SchemaFromFS(fsys fs.FS, name string) (Schema, error) {
+	var schema Schema
+	if err := schemaref.DecodeFS(fsys, name, &schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode file: %w", err)
+	}
+	if err := Lint(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
 	return schema, nil
 }
@@ -1,10 +1,15 @@
 package jbody
 
 import (
+	"io"
+	"io/fs"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/g8rswimmer/httpx/request/internal/field"
 	"github.com/g8rswimmer/httpx/request/internal/parameter"
@@ -344,3 +349,264 @@ func TestSchema_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_Validate_Body(t *testing.T) {
+	const body = `{"first_name": "Gary"}`
+	s := Schema{
+		Body: Body{
+			Object: &ObjectValidator{
+				Parameters: map[string]ParameterProperties{
+					"first_name": {
+						Validation: ParameterValidation{
+							String: &StringValidator{},
+						},
+					},
+				},
+			},
+		},
+	}
+	req := httptest.NewRequest(http.MethodPost, "https://www.test.this", strings.NewReader(body))
+	if err := s.Validate(req); err != nil {
+		t.Fatalf("Schema.Validate() error = %v", err)
+	}
+	value, ok := Value(req)
+	if !ok {
+		t.Fatalf("Value() decoded body not present")
+	}
+	if want := map[string]any{"first_name": "Gary"}; !reflect.DeepEqual(value, want) {
+		t.Errorf("Value() = %v, want %v", value, want)
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("request body read error = %v", err)
+	}
+	if string(b) != body {
+		t.Errorf("request body = %s, want %s", string(b), body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "https://www.test.this", strings.NewReader(`{"first_name":`))
+	if err := s.Validate(req); err == nil {
+		t.Fatalf("Schema.Validate() expected a decode error")
+	}
+	if _, ok := Value(req); ok {
+		t.Errorf("Value() decoded body should not be present")
+	}
+	if b, _ := io.ReadAll(req.Body); string(b) != `{"first_name":` {
+		t.Errorf("request body = %s, want the original body", string(b))
+	}
+}
+
+func TestSchemaFromYAML(t *testing.T) {
+	type args struct {
+		yaml string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				yaml: `
+title: User
+body:
+  object:
+    required_fields:
+      one_of:
+        - [name]
+    parameters:
+      name:
+        validation:
+          string_validator:
+            regex: "^[A-Z]"
+      born:
+        validation:
+          time_validator:
+            format: "2006-01-02"
+            after: 1900-01-01
+      address:
+        validation:
+          object_validator:
+            parameters:
+              zip:
+                validation:
+                  number_validator:
+                    min: 10000
+limits:
+  max_bytes: 1024
+  max_depth: 4
+`,
+			},
+			want: `{
+				"title": "User",
+				"body": {
+					"object": {
+						"required_fields": {"one_of": [["name"]]},
+						"parameters": {
+							"name": {"validation": {"string_validator": {"regex": "^[A-Z]"}}},
+							"born": {"validation": {"time_validator": {"format": "2006-01-02", "after": "1900-01-01"}}},
+							"address": {
+								"validation": {
+									"object_validator": {
+										"parameters": {
+											"zip": {"validation": {"number_validator": {"min": 10000}}}
+										}
+									}
+								}
+							}
+						}
+					}
+				},
+				"limits": {"max_bytes": 1024, "max_depth": 4}
+			}`,
+		},
+		{
+			name: "fail: decode",
+			args: args{
+				yaml: `
+body:
+  object:
+    parameters:
+      name:
+        validation:
+          string_validator:
+            regex: [A-Z]
+`,
+			},
+			wantErr: "line 8, column 20",
+		},
+		{
+			name: "fail: lint",
+			args: args{
+				yaml: `
+body:
+  object:
+    parameters:
+      name:
+        validation:
+          string_validator:
+            value: gary
+            regex: "^[A-Z]"
+`,
+			},
+			wantErr: "line 8, column 13",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SchemaFromYAML(strings.NewReader(tt.args.yaml))
+			if len(tt.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("SchemaFromYAML() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SchemaFromYAML() error = %v", err)
+			}
+			want, err := SchemaFromJSON(strings.NewReader(tt.want))
+			if err != nil {
+				t.Fatalf("SchemaFromJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("SchemaFromYAML() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSchemaFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"users.yaml": {Data: []byte(`
+body:
+  object:
+    parameters:
+      home_address:
+        validation:
+          object_validator:
+            $ref: common/address.json#/definitions/address
+      other_addresses:
+        validation:
+          object_array_validator:
+            object:
+              $ref: common/address.json#/definitions/address
+`)},
+		"common/address.json": {Data: []byte(`{
+			"definitions": {
+				"address": {
+					"required_fields": {"one_of": [["street_1", "zip"]]},
+					"parameters": {
+						"street_1": {"validation": {"string_validator": {}}},
+						"zip": {"$ref": "#/definitions/zip"}
+					}
+				},
+				"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}}
+			}
+		}`)},
+		"dangling.json": {Data: []byte(`{
+			"body": {
+				"object": {
+					"parameters": {
+						"home_address": {"validation": {"object_validator": {"$ref": "common/address.json#/definitions/adress"}}}
+					}
+				}
+			}
+		}`)},
+	}
+	type args struct {
+		fsys fs.FS
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success: yaml",
+			args: args{
+				fsys: fsys,
+				name: "users.yaml",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: example",
+			args: args{
+				fsys: os.DirFS("../../_examples/request/body/object"),
+				name: "object_schema.json",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: dangling",
+			args: args{
+				fsys: fsys,
+				name: "dangling.json",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SchemaFromFS(tt.args.fsys, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SchemaFromFS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parameters := got.Body.Object.Parameters
+			home := parameters["home_address"].Validation.Object
+			other := parameters["other_addresses"].Validation.ObjectArray
+			if home == nil || other == nil || !reflect.DeepEqual(*home, other.Object) {
+				t.Errorf("SchemaFromFS() home_address = %v, other_addresses = %v", home, other)
+			}
+			if len(home.Parameters) == 0 || home.Parameters["zip"].Validation.String == nil {
+				t.Errorf("SchemaFromFS() home_address = %v", home)
+			}
+		})
+	}
+}
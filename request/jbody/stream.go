@@ -0,0 +1,226 @@
+package jbody
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Limits bound the request JSON body.  When present the body is decoded token
+// by token and the decoding stops at the first limit that is exceeded, so the
+// rest of the body is not read.  A string is read at most six times the max
+// string length, the size of the longest escape of a byte, before it is
+// decoded.  A zero limit is not checked.  When the decoding fails the body is
+// restored with the bytes read followed by the rest of the body.
+type Limits struct {
+	MaxBytes        int64 `json:"max_bytes"`
+	MaxDepth        int   `json:"max_depth"`
+	MaxArrayLength  int   `json:"max_array_length"`
+	MaxStringLength int   `json:"max_string_length"`
+}
+
+// decodeStream reads and decodes the request body with the limits.  The bytes
+// read are kept so the body can be restored.
+func decodeStream(req *http.Request, limits Limits) (any, error) {
+	if limits.MaxBytes > 0 && req.ContentLength > limits.MaxBytes {
+		return nil, fmt.Errorf("schema body size [%d] is greater than %d bytes", req.ContentLength, limits.MaxBytes)
+	}
+	var body io.ReadCloser = http.NoBody
+	if req.Body != nil {
+		body = req.Body
+	}
+	raw := &bytes.Buffer{}
+	var reader io.Reader = io.TeeReader(body, raw)
+	if limits.MaxBytes > 0 {
+		reader = http.MaxBytesReader(nil, io.NopCloser(reader), limits.MaxBytes)
+	}
+	if limits.MaxStringLength > 0 {
+		reader = &stringReader{
+			r:   reader,
+			max: 6 * limits.MaxStringLength,
+		}
+	}
+
+	d := &streamDecoder{
+		dec:    json.NewDecoder(reader),
+		limits: limits,
+	}
+	value, err := d.value(location{}, 0)
+	if err == nil {
+		_, err = io.Copy(io.Discard, reader)
+	}
+	if err != nil {
+		req.Body = &restoredBody{
+			Reader: io.MultiReader(bytes.NewReader(raw.Bytes()), body),
+			Closer: body,
+		}
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return nil, fmt.Errorf("schema body size is greater than %d bytes", limits.MaxBytes)
+	case errors.Is(err, errStringLength):
+		return nil, d.limitErr(location{}, fmt.Sprintf("string length is greater than %d", limits.MaxStringLength))
+	case err != nil:
+		return nil, err
+	}
+	body.Close()
+	req.Body = &decodedBody{
+		Reader: bytes.NewReader(raw.Bytes()),
+		raw:    raw.Bytes(),
+		value:  value,
+	}
+	return value, nil
+}
+
+// restoredBody is the bytes read followed by the rest of the request body.
+type restoredBody struct {
+	io.Reader
+	io.Closer
+}
+
+var errStringLength = errors.New("string length limit")
+
+// stringReader stops reading when a string is longer than max bytes, so the
+// decoder never buffers the whole of a long string.
+type stringReader struct {
+	r        io.Reader
+	max      int
+	inString bool
+	escaped  bool
+	length   int
+	err      error
+}
+
+func (s *stringReader) Read(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	n, err := s.r.Read(p)
+	for i, b := range p[:n] {
+		switch {
+		case !s.inString:
+			if b == '"' {
+				s.inString = true
+				s.length = 0
+			}
+			continue
+		case s.escaped:
+			s.escaped = false
+		case b == '\\':
+			s.escaped = true
+		case b == '"':
+			s.inString = false
+			continue
+		}
+		s.length++
+		if s.length > s.max {
+			s.err = errStringLength
+			return i, s.err
+		}
+	}
+	return n, err
+}
+
+type streamDecoder struct {
+	dec    *json.Decoder
+	limits Limits
+}
+
+func (d *streamDecoder) value(loc location, depth int) (any, error) {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return nil, d.tokenErr(loc, err)
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		if d.limits.MaxDepth > 0 && depth >= d.limits.MaxDepth {
+			return nil, d.limitErr(loc, fmt.Sprintf("nesting depth is greater than %d", d.limits.MaxDepth))
+		}
+		if t == '{' {
+			return d.object(loc, depth+1)
+		}
+		return d.array(loc, depth+1)
+	case string:
+		if err := d.checkString(loc, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	default:
+		return t, nil
+	}
+}
+
+func (d *streamDecoder) object(loc location, depth int) (any, error) {
+	obj := map[string]any{}
+	for d.dec.More() {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return nil, d.tokenErr(loc, err)
+		}
+		name, _ := tok.(string)
+		key := loc.field(name)
+		if err := d.checkString(key, name); err != nil {
+			return nil, err
+		}
+		value, err := d.value(key, depth)
+		if err != nil {
+			return nil, err
+		}
+		obj[name] = value
+	}
+	if _, err := d.dec.Token(); err != nil {
+		return nil, d.tokenErr(loc, err)
+	}
+	return obj, nil
+}
+
+func (d *streamDecoder) array(loc location, depth int) (any, error) {
+	arr := []any{}
+	for d.dec.More() {
+		if d.limits.MaxArrayLength > 0 && len(arr) >= d.limits.MaxArrayLength {
+			return nil, d.limitErr(loc, fmt.Sprintf("array length is greater than %d", d.limits.MaxArrayLength))
+		}
+		value, err := d.value(loc.index(len(arr)), depth)
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, value)
+	}
+	if _, err := d.dec.Token(); err != nil {
+		return nil, d.tokenErr(loc, err)
+	}
+	return arr, nil
+}
+
+func (d *streamDecoder) checkString(loc location, value string) error {
+	if d.limits.MaxStringLength > 0 && len(value) > d.limits.MaxStringLength {
+		return d.limitErr(loc, fmt.Sprintf("string length [%d] is greater than %d", len(value), d.limits.MaxStringLength))
+	}
+	return nil
+}
+
+func (d *streamDecoder) limitErr(loc location, msg string) error {
+	c := newCollector(0)
+	key := loc
+	if len(key.key) == 0 {
+		key.key = "body"
+	}
+	c.add(key, msg)
+	return c.result()
+}
+
+func (d *streamDecoder) tokenErr(loc location, err error) error {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return err
+	case errors.Is(err, errStringLength):
+		return d.limitErr(loc, fmt.Sprintf("string length is greater than %d", d.limits.MaxStringLength))
+	}
+	return fmt.Errorf("schema body json decode: %w", err)
+}
@@ -0,0 +1,258 @@
+package jbody
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestSchema_Validate_Limits(t *testing.T) {
+	obj := &ObjectValidator{
+		Parameters: map[string]ParameterProperties{
+			"name": {Validation: ParameterValidation{String: &StringValidator{}}},
+			"ids":  {Validation: ParameterValidation{NumberArray: &NumberArrayValidator{}}},
+			"address": {
+				Validation: ParameterValidation{
+					Object: &ObjectValidator{
+						Parameters: map[string]ParameterProperties{
+							"street": {Validation: ParameterValidation{String: &StringValidator{}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	type args struct {
+		limits Limits
+		body   string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantPointer string
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			args: args{
+				limits: Limits{MaxBytes: 128, MaxDepth: 2, MaxArrayLength: 3, MaxStringLength: 8},
+				body:   `{"name": "gary", "ids": [1, 2, 3], "address": {"street": "main"}}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: no limits",
+			args: args{
+				body: `{"name": "gary", "ids": [1, 2, 3, 4, 5], "address": {"street": "main street"}}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: max bytes",
+			args: args{
+				limits: Limits{MaxBytes: 16},
+				body:   `{"name": "gary", "ids": [1, 2, 3]}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: max depth",
+			args: args{
+				limits: Limits{MaxDepth: 1},
+				body:   `{"name": "gary", "address": {"street": "main"}}`,
+			},
+			wantPointer: "/address",
+			wantErr:     true,
+		},
+		{
+			name: "fail: max array length",
+			args: args{
+				limits: Limits{MaxArrayLength: 2},
+				body:   `{"ids": [1, 2, 3]}`,
+			},
+			wantPointer: "/ids",
+			wantErr:     true,
+		},
+		{
+			name: "fail: max string length",
+			args: args{
+				limits: Limits{MaxStringLength: 8},
+				body:   `{"address": {"street": "main street"}}`,
+			},
+			wantPointer: "/address/street",
+			wantErr:     true,
+		},
+		{
+			name: "fail: max string length name",
+			args: args{
+				limits: Limits{MaxStringLength: 4},
+				body:   `{"address_line": "main"}`,
+			},
+			wantPointer: "/address_line",
+			wantErr:     true,
+		},
+		{
+			name: "fail: max string length read",
+			args: args{
+				limits: Limits{MaxStringLength: 8},
+				body:   `{"address": {"street": "main street main street main street main street main street"}, "name": "gary"}`,
+			},
+			wantPointer: "/address/street",
+			wantErr:     true,
+		},
+		{
+			name: "fail: json",
+			args: args{
+				limits: Limits{MaxDepth: 2},
+				body:   `{"name": "gary"`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := tt.args.limits
+			s := Schema{
+				Body:   Body{Object: obj},
+				Limits: &limits,
+			}
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com", strings.NewReader(tt.args.body))
+			err := s.Validate(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(tt.wantPointer) > 0 {
+				var schemaErr *rerror.SchemaErr
+				if !errors.As(err, &schemaErr) || schemaErr.Parameter == nil || len(schemaErr.Parameter.Violations) != 1 {
+					t.Fatalf("Schema.Validate() error = %v, want a parameter error", err)
+				}
+				if got := schemaErr.Parameter.Violations[0].Pointer; got != tt.wantPointer {
+					t.Errorf("Schema.Validate() pointer = %s, want %s", got, tt.wantPointer)
+				}
+			}
+			b, err := io.ReadAll(req.Body)
+			if err != nil || string(b) != tt.args.body {
+				t.Errorf("Schema.Validate() body = %s, error = %v", string(b), err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if _, ok := Value(req); !ok {
+				t.Errorf("Value() decoded body not present")
+			}
+		})
+	}
+}
+
+// endlessArray is a JSON array that never ends.
+type endlessArray struct {
+	read int
+}
+
+func (e *endlessArray) Read(p []byte) (int, error) {
+	for i := range p {
+		switch {
+		case e.read == 0:
+			p[i] = '['
+		case e.read%2 == 1:
+			p[i] = '0'
+		default:
+			p[i] = ','
+		}
+		e.read++
+	}
+	return len(p), nil
+}
+
+func TestSchema_Validate_LimitsStream(t *testing.T) {
+	type args struct {
+		limits Limits
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "max array length",
+			args: args{
+				limits: Limits{MaxArrayLength: 100},
+			},
+		},
+		{
+			name: "max bytes",
+			args: args{
+				limits: Limits{MaxBytes: 1 << 10},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Schema{
+				Body: Body{
+					ObjectArray: &ObjectArrayValidator{},
+				},
+				Limits: &tt.args.limits,
+			}
+			body := &endlessArray{}
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com", body)
+			if err := s.Validate(req); err == nil {
+				t.Fatalf("Schema.Validate() expected a limit error")
+			}
+			if body.read > 1<<16 {
+				t.Errorf("Schema.Validate() read %d bytes", body.read)
+			}
+		})
+	}
+}
+
+// endlessString is a JSON string that never ends.
+type endlessString struct {
+	read int
+}
+
+func (e *endlessString) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'a'
+		if e.read == 0 {
+			p[i] = '"'
+		}
+		e.read++
+	}
+	return len(p), nil
+}
+
+func TestSchema_Validate_LimitsStreamString(t *testing.T) {
+	s := Schema{
+		Body:   Body{Object: &ObjectValidator{}},
+		Limits: &Limits{MaxStringLength: 16},
+	}
+	body := &endlessString{}
+	req := httptest.NewRequest(http.MethodPost, "http://www.test.com", body)
+	err := s.Validate(req)
+	var schemaErr *rerror.SchemaErr
+	if !errors.As(err, &schemaErr) || schemaErr.Parameter == nil {
+		t.Fatalf("Schema.Validate() error = %v, want a parameter error", err)
+	}
+	if body.read > 1<<16 {
+		t.Errorf("Schema.Validate() read %d bytes", body.read)
+	}
+}
+
+func TestSchema_Validate_LimitsContentLength(t *testing.T) {
+	s := Schema{
+		Body:   Body{Object: &ObjectValidator{}},
+		Limits: &Limits{MaxBytes: 4},
+	}
+	req := httptest.NewRequest(http.MethodPost, "http://www.test.com", strings.NewReader(`{"name": "gary"}`))
+	err := s.Validate(req)
+	var schemaErr *rerror.SchemaErr
+	if !errors.As(err, &schemaErr) || !reflect.DeepEqual(schemaErr.Err, "schema body size [16] is greater than 4 bytes") {
+		t.Errorf("Schema.Validate() error = %v", err)
+	}
+}
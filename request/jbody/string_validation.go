@@ -41,3 +41,16 @@ func (s StringArrayValidator) Validate(value any) error {
 	}
 	return s.StringArrayValidator.Validate(strArr)
 }
+
+// Compile returns a copy of the validator with the reg exp compiled and the
+// one of values in a set.
+func (s StringValidator) Compile() (StringValidator, error) {
+	v, err := s.StringValidator.Compile()
+	return StringValidator{StringValidator: v}, err
+}
+
+// Compile returns a copy of the validator with the reg exp compiled.
+func (s StringArrayValidator) Compile() (StringArrayValidator, error) {
+	v, err := s.StringArrayValidator.Compile()
+	return StringArrayValidator{StringArrayValidator: v}, err
+}
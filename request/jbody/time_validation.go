@@ -41,3 +41,17 @@ func (s TimeArrayValidator) Validate(value any) error {
 	}
 	return s.TimeArrayValidator.Validate(strArr)
 }
+
+// Compile returns a copy of the validator with the before and after times
+// parsed.
+func (t TimeValidator) Compile() (TimeValidator, error) {
+	v, err := t.TimeValidator.Compile()
+	return TimeValidator{TimeValidator: v}, err
+}
+
+// Compile returns a copy of the validator with the before and after times
+// parsed.
+func (s TimeArrayValidator) Compile() (TimeArrayValidator, error) {
+	v, err := s.TimeArrayValidator.Compile()
+	return TimeArrayValidator{TimeArrayValidator: v}, err
+}
@@ -0,0 +1,35 @@
+package mbody
+
+import (
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	compiled.RequiredFields = s.RequiredFields.Clone()
+	parameters, issues := parameter.CompileParameters(s.Parameters)
+	compiled.Parameters = parameters
+	compiled.Files = make(map[string]FileProperties, len(s.Files))
+	for name, properties := range s.Files {
+		properties.ContentTypes = append([]string(nil), properties.ContentTypes...)
+		properties.Extensions = append([]string(nil), properties.Extensions...)
+		properties.SniffedContentTypes = append([]string(nil), properties.SniffedContentTypes...)
+		compiled.Files[name] = properties
+	}
+	if err := rerror.LintFromIssues("multipart schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate validates the request multipart body.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
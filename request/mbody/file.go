@@ -0,0 +1,110 @@
+package mbody
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// sniffLength is the number of bytes http.DetectContentType considers.
+const sniffLength = 512
+
+// FileProperties are the rules of the file parts with the same name.  Content
+// types can be a type wildcard, image/*, and extensions include the dot.  The
+// sniffed content types are matched against http.DetectContentType of the
+// file content, so a file can not pass with only a declared type or name.
+type FileProperties struct {
+	Description         string   `json:"description"`
+	MaxSize             int64    `json:"max_size"`
+	MaxCount            int      `json:"max_count"`
+	ContentTypes        []string `json:"content_types"`
+	Extensions          []string `json:"extensions"`
+	SniffedContentTypes []string `json:"sniffed_content_types"`
+}
+
+// Validate validates the file parts with the same name.
+func (f FileProperties) Validate(files []*multipart.FileHeader) error {
+	if f.MaxCount > 0 && len(files) > f.MaxCount {
+		return fmt.Errorf("file count [%d] is greater than %d", len(files), f.MaxCount)
+	}
+	for _, fh := range files {
+		if err := f.validateFile(fh); err != nil {
+			return fmt.Errorf("file [%s]: %w", fh.Filename, err)
+		}
+	}
+	return nil
+}
+
+func (f FileProperties) validateFile(fh *multipart.FileHeader) error {
+	if f.MaxSize > 0 && fh.Size > f.MaxSize {
+		return fmt.Errorf("size [%d] is greater than %d bytes", fh.Size, f.MaxSize)
+	}
+	if len(f.Extensions) > 0 {
+		ext := filepath.Ext(fh.Filename)
+		if !hasExtension(f.Extensions, ext) {
+			return fmt.Errorf("extension [%s] not in %v", ext, f.Extensions)
+		}
+	}
+	if len(f.ContentTypes) > 0 {
+		ct := fh.Header.Get("Content-Type")
+		if !hasContentType(f.ContentTypes, ct) {
+			return fmt.Errorf("content type [%s] not in %v", ct, f.ContentTypes)
+		}
+	}
+	if len(f.SniffedContentTypes) > 0 {
+		ct, err := sniff(fh)
+		if err != nil {
+			return err
+		}
+		if !hasContentType(f.SniffedContentTypes, ct) {
+			return fmt.Errorf("sniffed content type [%s] not in %v", ct, f.SniffedContentTypes)
+		}
+	}
+	return nil
+}
+
+func sniff(fh *multipart.FileHeader) (string, error) {
+	file, err := fh.Open()
+	if err != nil {
+		return "", fmt.Errorf("open: %w", err)
+	}
+	defer file.Close()
+	b := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, b)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", fmt.Errorf("read: %w", err)
+	}
+	return http.DetectContentType(b[:n]), nil
+}
+
+func hasExtension(extensions []string, ext string) bool {
+	for _, e := range extensions {
+		if strings.EqualFold(e, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasContentType returns if the media type of the content type is allowed,
+// the parameters are not compared.
+func hasContentType(allowed []string, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		switch {
+		case a == mediaType:
+			return true
+		case strings.HasSuffix(a, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(a, "*")):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package mbody
+
+import (
+	"fmt"
+	"mime"
+	"sort"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Lint returns an error with all of the schema rules that contradict each
+// other, or can never be satisfied.
+func Lint(schema Schema) error {
+	parts := field.Set(schema.Parameters)
+	for name := range schema.Files {
+		parts[name] = struct{}{}
+	}
+	issues := schema.RequiredFields.Lint("/required_fields", parts)
+	if schema.MaxBytes < 0 {
+		issues = append(issues, rerror.LintIssue{
+			Path: "/max_bytes",
+			Msg:  "max bytes can not be negative",
+		})
+	}
+	if schema.MaxMemory < 0 {
+		issues = append(issues, rerror.LintIssue{
+			Path: "/max_memory",
+			Msg:  "max memory can not be negative",
+		})
+	}
+
+	for _, param := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[param]
+		path := "/parameters/" + rerror.PointerToken(param)
+		v := properties.Validation
+		if properties.InlineArray && (v.String != nil || v.Number != nil || v.Time != nil || v.Boolean != nil) {
+			issues = append(issues, rerror.LintIssue{
+				Path: path + "/inline_array",
+				Msg:  "inline array requires an array validator",
+			})
+		}
+		issues = append(issues, v.Lint(path+"/validation")...)
+	}
+	for _, name := range sortedKeys(schema.Files) {
+		issues = append(issues, schema.Files[name].lint("/files/"+rerror.PointerToken(name))...)
+	}
+	return rerror.LintFromIssues("multipart schema lint", issues)
+}
+
+func (f FileProperties) lint(path string) []rerror.LintIssue {
+	var issues []rerror.LintIssue
+	if f.MaxSize < 0 {
+		issues = append(issues, rerror.LintIssue{
+			Path: path + "/max_size",
+			Msg:  "max size can not be negative",
+		})
+	}
+	if f.MaxCount < 0 {
+		issues = append(issues, rerror.LintIssue{
+			Path: path + "/max_count",
+			Msg:  "max count can not be negative",
+		})
+	}
+	for i, ext := range f.Extensions {
+		if !strings.HasPrefix(ext, ".") {
+			issues = append(issues, rerror.LintIssue{
+				Path: fmt.Sprintf("%s/extensions/%d", path, i),
+				Msg:  fmt.Sprintf("extension [%s] must start with a dot", ext),
+			})
+		}
+	}
+	issues = append(issues, lintContentTypes(path+"/content_types", f.ContentTypes)...)
+	issues = append(issues, lintContentTypes(path+"/sniffed_content_types", f.SniffedContentTypes)...)
+	return issues
+}
+
+func lintContentTypes(path string, contentTypes []string) []rerror.LintIssue {
+	var issues []rerror.LintIssue
+	for i, ct := range contentTypes {
+		if _, _, err := mime.ParseMediaType(ct); err != nil || !strings.Contains(ct, "/") {
+			issues = append(issues, rerror.LintIssue{
+				Path: fmt.Sprintf("%s/%d", path, i),
+				Msg:  fmt.Sprintf("content type [%s] is not a media type", ct),
+			})
+		}
+	}
+	return issues
+}
+
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
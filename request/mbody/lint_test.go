@@ -0,0 +1,86 @@
+package mbody
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestSchemaFromJSON_Lint(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: testSchemaJSON,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "fail: contradictions",
+			args: args{
+				schema: `{
+					"max_bytes": -1,
+					"parameters": {
+						"name": {"inline_array": true, "inline_array_seperator": ",", "validation": {"string_validator": {}}}
+					},
+					"files": {
+						"avatar": {"max_size": -1, "content_types": ["image"], "extensions": ["png"], "sniffed_content_types": ["image/png"]}
+					}
+				}`,
+			},
+			want: []string{
+				"/max_bytes",
+				"/parameters/name/inline_array",
+				"/files/avatar/max_size",
+				"/files/avatar/extensions/0",
+				"/files/avatar/content_types/0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: file is a parameter",
+			args: args{
+				schema: `{
+					"parameters": {"avatar": {"validation": {"string_validator": {}}}},
+					"files": {"avatar": {}}
+				}`,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromJSON(strings.NewReader(tt.args.schema))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SchemaFromJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("SchemaFromJSON() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SchemaFromJSON() lint paths = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
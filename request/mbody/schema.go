@@ -0,0 +1,146 @@
+package mbody
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/query"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const (
+	contentType = "multipart/form-data"
+
+	// DefaultMaxMemory is the multipart form memory used when the schema does
+	// not have one, the rest of the files are stored on disk.
+	DefaultMaxMemory = 32 << 20
+)
+
+// Schema validates a multipart/form-data request body.  The text parts are
+// validated with the query schema model and the file parts with the file
+// properties.  The required fields are the text and file part names.
+type Schema struct {
+	Title          string                               `json:"title"`
+	Description    string                               `json:"description"`
+	RequiredFields field.Required                       `json:"required_fields"`
+	Parameters     map[string]query.ParameterProperties `json:"parameters"`
+	Files          map[string]FileProperties            `json:"files"`
+	MaxBytes       int64                                `json:"max_bytes"`
+	MaxMemory      int64                                `json:"max_memory"`
+}
+
+// Validate parses and validates the request multipart body.  The request
+// MultipartForm is populated, so handlers use FormValue and FormFile instead of
+// reading the body.
+func (s Schema) Validate(req *http.Request) error {
+	form, err := s.multipartForm(req)
+	if err != nil {
+		return rerror.SchemaFromError("request multipart validation", err)
+	}
+
+	parts := map[string]struct{}{}
+	for name := range form.Value {
+		parts[name] = struct{}{}
+	}
+	for name := range form.File {
+		parts[name] = struct{}{}
+	}
+	if err := field.Validate(form.Value, s.Parameters); err != nil {
+		return rerror.SchemaFromError("request multipart validation", err)
+	}
+	if err := field.Validate(form.File, s.Files); err != nil {
+		return rerror.SchemaFromError("request multipart validation", err)
+	}
+
+	if err := s.RequiredFields.Validate(parts); err != nil {
+		return rerror.SchemaFromError("request multipart validation", err)
+	}
+
+	parameterErr := &rerror.ParameterErr{
+		Parameters: map[string]string{},
+	}
+	for name, properties := range s.Parameters {
+		if err := properties.ValidateValues(form.Value[name]); err != nil {
+			parameterErr.Add(name, fmt.Sprintf("multipart validation: %v", err))
+		}
+	}
+	for name, properties := range s.Files {
+		if err := properties.Validate(form.File[name]); err != nil {
+			parameterErr.Add(name, fmt.Sprintf("multipart validation: %v", err))
+		}
+	}
+	return rerror.SchemaFromError("request multipart validation", parameterErr)
+}
+
+func (s Schema) multipartForm(req *http.Request) (*multipart.Form, error) {
+	if req.MultipartForm != nil {
+		return req.MultipartForm, nil
+	}
+	ct := req.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(ct); err != nil || mediaType != contentType {
+		return nil, fmt.Errorf("request content type [%s] is not %s", ct, contentType)
+	}
+	if s.MaxBytes > 0 {
+		if req.ContentLength > s.MaxBytes {
+			return nil, fmt.Errorf("schema body size [%d] is greater than %d bytes", req.ContentLength, s.MaxBytes)
+		}
+		req.Body = http.MaxBytesReader(nil, req.Body, s.MaxBytes)
+	}
+	maxMemory := s.MaxMemory
+	if maxMemory == 0 {
+		maxMemory = DefaultMaxMemory
+	}
+	if err := req.ParseMultipartForm(maxMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, fmt.Errorf("schema body size is greater than %d bytes", s.MaxBytes)
+		}
+		return nil, fmt.Errorf("schema multipart parse: %w", err)
+	}
+	return req.MultipartForm, nil
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case len(schema.Parameters) == 0 && len(schema.Files) == 0:
+		return errors.New("schema parameters or files are required")
+	default:
+	}
+	parts := map[string]struct{}{}
+	for param, properties := range schema.Parameters {
+		if err := query.SchemaModelParameterPropertiesValidator(properties); err != nil {
+			return fmt.Errorf("schema parameter [%s]: %w", param, err)
+		}
+		parts[param] = struct{}{}
+	}
+	for name := range schema.Files {
+		if _, has := parts[name]; has {
+			return fmt.Errorf("schema file [%s]: already a parameter", name)
+		}
+		parts[name] = struct{}{}
+	}
+	if err := schema.RequiredFields.Validate(parts); err != nil {
+		return fmt.Errorf("schema required parts missing: %w", err)
+	}
+	if err := Lint(schema); err != nil {
+		return err
+	}
+	return nil
+}
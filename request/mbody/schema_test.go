@@ -0,0 +1,191 @@
+package mbody
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+const testSchemaJSON = `{
+	"required_fields": {"one_of": [["name", "avatar"]]},
+	"parameters": {
+		"name": {"validation": {"string_validator": {"regex": "^[A-Z][a-z]+$"}}},
+		"tags": {"validation": {"string_array_validator": {}}}
+	},
+	"files": {
+		"avatar": {
+			"max_size": 1024,
+			"max_count": 1,
+			"content_types": ["image/*"],
+			"extensions": [".png", ".jpg"],
+			"sniffed_content_types": ["image/png", "image/jpeg"]
+		},
+		"imports": {
+			"max_count": 2,
+			"content_types": ["text/csv"],
+			"extensions": [".csv"]
+		}
+	}
+}`
+
+var testPNG = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...)
+
+type testPart struct {
+	name        string
+	filename    string
+	contentType string
+	content     []byte
+}
+
+func testRequest(t *testing.T, parts ...testPart) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	for _, p := range parts {
+		if len(p.filename) == 0 {
+			if err := w.WriteField(p.name, string(p.content)); err != nil {
+				t.Fatalf("multipart write field error = %v", err)
+			}
+			continue
+		}
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, p.name, p.filename))
+		h.Set("Content-Type", p.contentType)
+		part, err := w.CreatePart(h)
+		if err != nil {
+			t.Fatalf("multipart create part error = %v", err)
+		}
+		part.Write(p.content)
+	}
+	w.Close()
+	req := httptest.NewRequest(http.MethodPost, "http://www.test.com/users", body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	name := testPart{name: "name", content: []byte("Gary")}
+	avatar := testPart{name: "avatar", filename: "me.png", contentType: "image/png", content: testPNG}
+	csv := testPart{name: "imports", filename: "users.csv", contentType: "text/csv", content: []byte("name\ngary\n")}
+	type args struct {
+		parts []testPart
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				parts: []testPart{name, avatar, csv, csv, {name: "tags", content: []byte("a")}, {name: "tags", content: []byte("b")}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: required",
+			args: args{
+				parts: []testPart{name},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: unknown part",
+			args: args{
+				parts: []testPart{name, avatar, {name: "resume", filename: "cv.pdf", contentType: "application/pdf", content: []byte("%PDF-")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: text parameter",
+			args: args{
+				parts: []testPart{{name: "name", content: []byte("gary")}, avatar},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: max count",
+			args: args{
+				parts: []testPart{name, avatar, csv, csv, csv},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: max size",
+			args: args{
+				parts: []testPart{name, {name: "avatar", filename: "me.png", contentType: "image/png", content: append(testPNG, make([]byte, 1024)...)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: content type",
+			args: args{
+				parts: []testPart{name, {name: "avatar", filename: "me.png", contentType: "application/octet-stream", content: testPNG}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: extension",
+			args: args{
+				parts: []testPart{name, {name: "avatar", filename: "me.gif", contentType: "image/png", content: testPNG}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: sniffed content type",
+			args: args{
+				parts: []testPart{name, {name: "avatar", filename: "setup.png", contentType: "image/png", content: []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00")}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := schema.Validate(testRequest(t, tt.args.parts...)); (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchema_Validate_Request(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	req := testRequest(t, testPart{name: "name", content: []byte("Gary")}, testPart{name: "avatar", filename: "me.png", contentType: "image/png", content: testPNG})
+	if err := compiled.Validate(req); err != nil {
+		t.Fatalf("CompiledSchema.Validate() error = %v", err)
+	}
+	if got := req.FormValue("name"); got != "Gary" {
+		t.Errorf("Request.FormValue() = %s, want Gary", got)
+	}
+	if _, fh, err := req.FormFile("avatar"); err != nil || fh.Filename != "me.png" {
+		t.Errorf("Request.FormFile() error = %v", err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "http://www.test.com/users", strings.NewReader("name=Gary"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := schema.Validate(req); err == nil {
+		t.Errorf("Schema.Validate() expected a content type error")
+	}
+
+	limited := schema
+	limited.MaxBytes = 64
+	if err := limited.Validate(testRequest(t, testPart{name: "name", content: []byte("Gary")}, testPart{name: "avatar", filename: "me.png", contentType: "image/png", content: testPNG})); err == nil {
+		t.Errorf("Schema.Validate() expected a max bytes error")
+	}
+}
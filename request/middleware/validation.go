@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const (
+	DefaultStatusCode  = http.StatusBadRequest
+	DefaultContentType = "application/json"
+)
+
+type Validator interface {
+	Validate(req *http.Request) error
+}
+
+type ErrorWriter func(w http.ResponseWriter, req *http.Request, err error)
+
+type Validation struct {
+	Validator   Validator
+	StatusCode  int
+	ContentType string
+	ErrorWriter ErrorWriter
+}
+
+func (v Validation) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		err := v.Validator.Validate(req)
+		switch {
+		case err == nil:
+			next.ServeHTTP(w, req)
+		case v.ErrorWriter != nil:
+			v.ErrorWriter(w, req, err)
+		default:
+			v.writeError(w, err)
+		}
+	})
+}
+
+func (v Validation) writeError(w http.ResponseWriter, err error) {
+	var (
+		routeErr     *rerror.RouteErr
+		mediaTypeErr *rerror.MediaTypeErr
+		schemaErr    *rerror.SchemaErr
+	)
+	switch {
+	case errors.As(err, &routeErr):
+		if len(routeErr.Allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(routeErr.Allowed, ", "))
+		}
+		v.write(w, routeErr.StatusCode, routeErr)
+	case errors.As(err, &mediaTypeErr):
+		v.write(w, mediaTypeErr.StatusCode, mediaTypeErr)
+	case errors.As(err, &schemaErr):
+		v.write(w, v.statusCode(), schemaErr)
+	default:
+		v.write(w, v.statusCode(), &rerror.SchemaErr{
+			Msg: "request validation",
+			Err: err.Error(),
+		})
+	}
+}
+
+func (v Validation) write(w http.ResponseWriter, statusCode int, body any) {
+	enc, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", v.contentType())
+	w.WriteHeader(statusCode)
+	w.Write(enc)
+}
+
+func (v Validation) statusCode() int {
+	if v.StatusCode == 0 {
+		return DefaultStatusCode
+	}
+	return v.StatusCode
+}
+
+func (v Validation) contentType() string {
+	if len(v.ContentType) == 0 {
+		return DefaultContentType
+	}
+	return v.ContentType
+}
+
+func Validate(validator Validator) func(http.Handler) http.Handler {
+	return Validation{
+		Validator: validator,
+	}.Handler
+}
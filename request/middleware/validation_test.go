@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type validatorFunc func(req *http.Request) error
+
+func (f validatorFunc) Validate(req *http.Request) error {
+	return f(req)
+}
+
+func TestValidation_Handler(t *testing.T) {
+	type fields struct {
+		Validator   Validator
+		StatusCode  int
+		ContentType string
+		ErrorWriter ErrorWriter
+	}
+	type args struct {
+		req *http.Request
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		args            args
+		wantStatusCode  int
+		wantContentType string
+		wantNext        bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Validator: endpoint.Schema{
+					Method:   http.MethodGet,
+					Endpoint: "/success",
+				},
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/success", nil),
+			},
+			wantStatusCode: http.StatusOK,
+			wantNext:       true,
+		},
+		{
+			name: "fail: default response",
+			fields: fields{
+				Validator: endpoint.Schema{
+					Method:   http.MethodGet,
+					Endpoint: "/success",
+				},
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/success", nil),
+			},
+			wantStatusCode:  http.StatusBadRequest,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "fail: configured response",
+			fields: fields{
+				Validator: endpoint.Schema{
+					Method:   http.MethodGet,
+					Endpoint: "/success",
+				},
+				StatusCode:  http.StatusUnprocessableEntity,
+				ContentType: "application/problem+json",
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/success", nil),
+			},
+			wantStatusCode:  http.StatusUnprocessableEntity,
+			wantContentType: "application/problem+json",
+		},
+		{
+			name: "fail: non schema error",
+			fields: fields{
+				Validator: validatorFunc(func(req *http.Request) error {
+					return errors.New("some error")
+				}),
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/success", nil),
+			},
+			wantStatusCode:  http.StatusBadRequest,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "fail: route error",
+			fields: fields{
+				Validator: validatorFunc(func(req *http.Request) error {
+					return &rerror.RouteErr{
+						Msg:        "request method not allowed",
+						StatusCode: http.StatusMethodNotAllowed,
+						Allowed:    []string{http.MethodGet},
+					}
+				}),
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/success", nil),
+			},
+			wantStatusCode:  http.StatusMethodNotAllowed,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "fail: media type error",
+			fields: fields{
+				Validator: validatorFunc(func(req *http.Request) error {
+					return &rerror.MediaTypeErr{
+						Msg:         "request media type [text/plain] is not supported",
+						StatusCode:  http.StatusUnsupportedMediaType,
+						ContentType: "text/plain",
+						Supported:   []string{"application/json"},
+					}
+				}),
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/success", nil),
+			},
+			wantStatusCode:  http.StatusUnsupportedMediaType,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "fail: error writer",
+			fields: fields{
+				Validator: endpoint.Schema{
+					Method:   http.MethodGet,
+					Endpoint: "/success",
+				},
+				ErrorWriter: func(w http.ResponseWriter, req *http.Request, err error) {
+					w.Header().Set("Content-Type", "text/plain")
+					w.WriteHeader(http.StatusTeapot)
+				},
+			},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/success", nil),
+			},
+			wantStatusCode:  http.StatusTeapot,
+			wantContentType: "text/plain",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Validation{
+				Validator:   tt.fields.Validator,
+				StatusCode:  tt.fields.StatusCode,
+				ContentType: tt.fields.ContentType,
+				ErrorWriter: tt.fields.ErrorWriter,
+			}
+			called := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+			mux := http.NewServeMux()
+			mux.Handle("/", v.Handler(next))
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, tt.args.req)
+
+			if called != tt.wantNext {
+				t.Errorf("Validation.Handler() next called = %v, want %v", called, tt.wantNext)
+			}
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Validation.Handler() status code = %d, want %d", rec.Code, tt.wantStatusCode)
+			}
+			if tt.wantNext {
+				return
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Validation.Handler() content type = %s, want %s", got, tt.wantContentType)
+			}
+			if tt.fields.ErrorWriter != nil {
+				return
+			}
+			var schemaErr rerror.SchemaErr
+			if err := json.Unmarshal(rec.Body.Bytes(), &schemaErr); err != nil {
+				t.Errorf("Validation.Handler() body decode error = %v", err)
+			}
+		})
+	}
+}
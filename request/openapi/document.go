@@ -0,0 +1,214 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Components struct {
+	Schemas       map[string]*Schema     `json:"schemas,omitempty"`
+	Parameters    map[string]Parameter   `json:"parameters,omitempty"`
+	RequestBodies map[string]RequestBody `json:"requestBodies,omitempty"`
+}
+
+type PathItem struct {
+	Summary     string      `json:"summary,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Parameters  []Parameter `json:"parameters,omitempty"`
+	Get         *Operation  `json:"get,omitempty"`
+	Put         *Operation  `json:"put,omitempty"`
+	Post        *Operation  `json:"post,omitempty"`
+	Delete      *Operation  `json:"delete,omitempty"`
+	Options     *Operation  `json:"options,omitempty"`
+	Head        *Operation  `json:"head,omitempty"`
+	Patch       *Operation  `json:"patch,omitempty"`
+	Trace       *Operation  `json:"trace,omitempty"`
+}
+
+// Operations returns the path item operations keyed by the HTTP method.
+func (p PathItem) Operations() map[string]*Operation {
+	operations := map[string]*Operation{}
+	for method, op := range map[string]*Operation{
+		"GET":     p.Get,
+		"PUT":     p.Put,
+		"POST":    p.Post,
+		"DELETE":  p.Delete,
+		"OPTIONS": p.Options,
+		"HEAD":    p.Head,
+		"PATCH":   p.Patch,
+		"TRACE":   p.Trace,
+	} {
+		if op != nil {
+			operations[method] = op
+		}
+	}
+	return operations
+}
+
+func (p *PathItem) SetOperation(method string, op *Operation) error {
+	var target **Operation
+	switch method {
+	case "GET":
+		target = &p.Get
+	case "PUT":
+		target = &p.Put
+	case "POST":
+		target = &p.Post
+	case "DELETE":
+		target = &p.Delete
+	case "OPTIONS":
+		target = &p.Options
+	case "HEAD":
+		target = &p.Head
+	case "PATCH":
+		target = &p.Patch
+	case "TRACE":
+		target = &p.Trace
+	default:
+		return fmt.Errorf("method [%s] is not supported", method)
+	}
+	if *target != nil {
+		return fmt.Errorf("method [%s] operation already present", method)
+	}
+	*target = op
+	return nil
+}
+
+type Operation struct {
+	OperationID string              `json:"operationId,omitempty"`
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses,omitempty"`
+}
+
+type Parameter struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Name        string               `json:"name,omitempty"`
+	In          string               `json:"in,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Style       string               `json:"style,omitempty"`
+	Explode     *bool                `json:"explode,omitempty"`
+	Schema      *Schema              `json:"schema,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+	Example     any                  `json:"example,omitempty"`
+}
+
+type RequestBody struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema  *Schema `json:"schema,omitempty"`
+	Example any     `json:"example,omitempty"`
+}
+
+type Schema struct {
+	Ref                  string              `json:"$ref,omitempty"`
+	Type                 Types               `json:"type,omitempty"`
+	Format               string              `json:"format,omitempty"`
+	ContentMediaType     string              `json:"contentMediaType,omitempty"`
+	Title                string              `json:"title,omitempty"`
+	Description          string              `json:"description,omitempty"`
+	Enum                 []any               `json:"enum,omitempty"`
+	Const                any                 `json:"const,omitempty"`
+	Pattern              *string             `json:"pattern,omitempty"`
+	Minimum              *float64            `json:"minimum,omitempty"`
+	Maximum              *float64            `json:"maximum,omitempty"`
+	ExclusiveMinimum     any                 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     any                 `json:"exclusiveMaximum,omitempty"`
+	MultipleOf           *float64            `json:"multipleOf,omitempty"`
+	MinLength            *int                `json:"minLength,omitempty"`
+	MaxLength            *int                `json:"maxLength,omitempty"`
+	Items                *Schema             `json:"items,omitempty"`
+	MinItems             *int                `json:"minItems,omitempty"`
+	MaxItems             *int                `json:"maxItems,omitempty"`
+	UniqueItems          bool                `json:"uniqueItems,omitempty"`
+	Contains             *Schema             `json:"contains,omitempty"`
+	Properties           map[string]*Schema  `json:"properties,omitempty"`
+	Required             []string            `json:"required,omitempty"`
+	DependentRequired    map[string][]string `json:"dependentRequired,omitempty"`
+	AdditionalProperties any                 `json:"additionalProperties,omitempty"`
+	OneOf                []*Schema           `json:"oneOf,omitempty"`
+	AnyOf                []*Schema           `json:"anyOf,omitempty"`
+	AllOf                []*Schema           `json:"allOf,omitempty"`
+	Not                  *Schema             `json:"not,omitempty"`
+	Nullable             bool                `json:"nullable,omitempty"`
+	Example              any                 `json:"example,omitempty"`
+	XML                  *XML                `json:"xml,omitempty"`
+	Examples             []any               `json:"examples,omitempty"`
+}
+
+// Types is the schema type, OpenAPI 3.0 uses a single type where 3.1 allows
+// a list of types.
+type Types []string
+
+func (t *Types) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = Types{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return errors.New("schema type must be a string or array of strings")
+	}
+	*t = multiple
+	return nil
+}
+
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// Kind returns the non null type and if the null type is present.
+func (t Types) Kind() (string, bool, error) {
+	kind := ""
+	null := false
+	for _, typ := range t {
+		switch {
+		case typ == "null":
+			null = true
+		case len(kind) > 0:
+			return "", false, fmt.Errorf("multiple types %v", []string(t))
+		default:
+			kind = typ
+		}
+	}
+	return kind, null, nil
+}
+
+type XML struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Prefix    string `json:"prefix,omitempty"`
+	Attribute bool   `json:"attribute,omitempty"`
+	Wrapped   bool   `json:"wrapped,omitempty"`
+}
@@ -0,0 +1,740 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request"
+	"github.com/g8rswimmer/httpx/request/cookie"
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/form"
+	"github.com/g8rswimmer/httpx/request/header"
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/mbody"
+	"github.com/g8rswimmer/httpx/request/query"
+	"github.com/g8rswimmer/httpx/request/xbody"
+)
+
+const Version = "3.1.0"
+
+func ExportJSON(writer io.Writer, info Info, schemas ...request.Schema) ([]Unsupported, error) {
+	doc, unsupported, err := Export(info, schemas...)
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(writer)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("openapi encode json: %w", err)
+	}
+	return unsupported, nil
+}
+
+// Export converts the request schemas into an OpenAPI 3.1 document, every
+// schema requires an endpoint section. The schema rules that do not have an
+// OpenAPI equivalent are returned as unsupported.
+//
+// The required field one of combinations are satisfied when any combination is
+// present, so they are exported as anyOf required.
+func Export(info Info, schemas ...request.Schema) (Document, []Unsupported, error) {
+	e := &exporter{}
+	doc := Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+	}
+	for idx, schema := range schemas {
+		if schema.Endpoint == nil {
+			return Document{}, nil, fmt.Errorf("schema [%d] endpoint is required", idx)
+		}
+		path, op := e.operation(schema)
+		item := doc.Paths[path]
+		if err := item.SetOperation(strings.ToUpper(schema.Endpoint.Method), op); err != nil {
+			return Document{}, nil, fmt.Errorf("schema [%d] path [%s]: %w", idx, path, err)
+		}
+		doc.Paths[path] = item
+	}
+	return doc, e.unsupported, nil
+}
+
+type exporter struct {
+	unsupported []Unsupported
+}
+
+func (e *exporter) report(location string, format string, args ...any) {
+	e.unsupported = append(e.unsupported, Unsupported{
+		Location: location,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (e *exporter) operation(schema request.Schema) (string, *Operation) {
+	ep := schema.Endpoint
+	op := &Operation{
+		Summary:     schema.Title,
+		Description: schema.Description,
+	}
+	if len(op.Summary) == 0 {
+		op.Summary = ep.Title
+	}
+	if len(op.Description) == 0 {
+		op.Description = ep.Description
+	}
+
+	segments := strings.Split(ep.Endpoint, "/")
+	variables := map[string]string{}
+	for idx, segment := range segments {
+		if _, has := ep.PathVariables[segment]; has {
+			name := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+			segments[idx] = "{" + name + "}"
+			variables[segment] = name
+		}
+	}
+	path := strings.Join(segments, "/")
+	location := ep.Method + " " + path
+	for _, segment := range sortedKeys(ep.PathVariables) {
+		name, has := variables[segment]
+		if !has {
+			e.report(location, "path variable [%s] is not a path segment", segment)
+			continue
+		}
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   e.schema(fmt.Sprintf("%s path [%s]", location, name), fromVariable(ep.PathVariables[segment].Validation), true),
+		})
+	}
+
+	if schema.Query != nil {
+		op.Parameters = append(op.Parameters, e.query(location, *schema.Query)...)
+	}
+	if schema.Header != nil {
+		op.Parameters = append(op.Parameters, e.header(location, *schema.Header)...)
+	}
+	if schema.Cookie != nil {
+		op.Parameters = append(op.Parameters, e.cookie(location, *schema.Cookie)...)
+	}
+	if schema.Body != nil {
+		op.RequestBody = e.body(location, *schema.Body)
+	}
+	if schema.Form != nil {
+		op.RequestBody = e.form(location, *schema.Form)
+	}
+	if schema.Multipart != nil {
+		op.RequestBody = e.multipart(location, *schema.Multipart)
+	}
+	if schema.XML != nil {
+		op.RequestBody = e.xml(location, *schema.XML)
+	}
+	if schema.Content != nil {
+		op.RequestBody = e.content(location, *schema.Content)
+	}
+	return path, op
+}
+
+func (e *exporter) query(location string, schema query.Schema) []Parameter {
+	location += " query"
+	required := e.required(location, schema.RequiredFields)
+	params := []Parameter{}
+	for _, name := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[name]
+		paramLocation := fmt.Sprintf("%s [%s]", location, name)
+		v := fromQuery(properties.Validation)
+		p := Parameter{
+			Name:        name,
+			In:          "query",
+			Description: properties.Description,
+			Required:    required[name],
+			Schema:      e.schema(paramLocation, v, false),
+		}
+		if len(properties.Example) > 0 {
+			p.Example = properties.Example
+		}
+		if e.inlineArray(paramLocation, v, properties.InlineArray) {
+			explode := false
+			p.Explode = &explode
+			switch properties.InlineArraySeperator {
+			case ",":
+				p.Style = "form"
+			case " ":
+				p.Style = "spaceDelimited"
+			case "|":
+				p.Style = "pipeDelimited"
+			default:
+				p.Style = "form"
+				e.report(paramLocation, "inline array seperator [%s] has no style equivalent", properties.InlineArraySeperator)
+			}
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+func (e *exporter) header(location string, schema header.Schema) []Parameter {
+	location += " header"
+	required := e.required(location, schema.RequiredFields)
+	params := []Parameter{}
+	for _, name := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[name]
+		paramLocation := fmt.Sprintf("%s [%s]", location, name)
+		v := fromHeader(properties.Validation)
+		p := Parameter{
+			Name:        name,
+			In:          "header",
+			Description: properties.Description,
+			Required:    required[name],
+			Schema:      e.schema(paramLocation, v, false),
+		}
+		if len(properties.Example) > 0 {
+			p.Example = properties.Example
+		}
+		// multiple header values are validated as arrays without the inline array
+		array := v.StringArray != nil || v.NumberArray != nil || v.TimeArray != nil
+		if array && properties.InlineArray && properties.InlineArraySeperator != "," {
+			e.report(paramLocation, "inline array seperator [%s] has no style equivalent", properties.InlineArraySeperator)
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+func (e *exporter) cookie(location string, schema cookie.Schema) []Parameter {
+	location += " cookie"
+	required := e.required(location, schema.RequiredFields)
+	params := []Parameter{}
+	for _, name := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[name]
+		paramLocation := fmt.Sprintf("%s [%s]", location, name)
+		v := fromCookie(properties.Validation)
+		p := Parameter{
+			Name:        name,
+			In:          "cookie",
+			Description: properties.Description,
+			Required:    required[name],
+			Schema:      e.schema(paramLocation, v, false),
+		}
+		if len(properties.Example) > 0 {
+			p.Example = properties.Example
+		}
+		if properties.MaxLength != nil {
+			if v.String != nil {
+				p.Schema.MaxLength = properties.MaxLength
+			} else {
+				e.report(paramLocation, "max length only has an equivalent for string values")
+			}
+		}
+		if e.inlineArray(paramLocation, v, properties.InlineArray) {
+			explode := false
+			p.Style = "form"
+			p.Explode = &explode
+			if properties.InlineArraySeperator != "," {
+				e.report(paramLocation, "inline array seperator [%s] has no style equivalent", properties.InlineArraySeperator)
+			}
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+// inlineArray reports the array parameters that are not inline arrays, only
+// inline arrays are able to be validated.
+func (e *exporter) inlineArray(location string, v validators, inlineArray bool) bool {
+	array := v.StringArray != nil || v.NumberArray != nil || v.TimeArray != nil
+	switch {
+	case array && !inlineArray:
+		e.report(location, "array validation requires an inline array")
+	case !array && inlineArray:
+		e.report(location, "inline array requires an array validation")
+	default:
+	}
+	return array && inlineArray
+}
+
+func (e *exporter) required(location string, required field.Required) map[string]bool {
+	set := map[string]bool{}
+	switch len(required.OneOf) {
+	case 0:
+	case 1:
+		for _, name := range required.OneOf[0] {
+			set[name] = true
+		}
+	default:
+		e.report(location, "required one of combinations %v have no parameter equivalent", required.OneOf)
+	}
+	if len(required.Present) > 0 {
+		e.report(location, "required present fields %v have no parameter equivalent", required.Present)
+	}
+	return set
+}
+
+func (e *exporter) body(location string, schema jbody.Schema) *RequestBody {
+	location += " body"
+	var s *Schema
+	switch {
+	case schema.Body.Object != nil:
+		s = e.object(location, *schema.Body.Object)
+	case schema.Body.ObjectArray != nil:
+		s = &Schema{
+			Type:  Types{"array"},
+			Items: e.object(location+"[]", schema.Body.ObjectArray.Object),
+		}
+	default:
+		e.report(location, "body requires an object or object array")
+		return nil
+	}
+	s.Title = schema.Title
+	s.Description = schema.Description
+	return &RequestBody{
+		Description: schema.Description,
+		Required:    true,
+		Content: map[string]MediaType{
+			"application/json": {
+				Schema: s,
+			},
+		},
+	}
+}
+
+func (e *exporter) object(location string, obj jbody.ObjectValidator) *Schema {
+	s := &Schema{
+		Type:                 Types{"object"},
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: false,
+	}
+	for _, name := range sortedKeys(obj.Parameters) {
+		s.Properties[name] = e.schema(location+"."+name, fromJBody(obj.Parameters[name].Validation), true)
+	}
+	requiredProperties(s, obj.RequiredFields)
+	return s
+}
+
+func (e *exporter) form(location string, schema form.Schema) *RequestBody {
+	location += " form"
+	s := &Schema{
+		Type:                 Types{"object"},
+		Title:                schema.Title,
+		Description:          schema.Description,
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: false,
+	}
+	for _, name := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[name]
+		paramLocation := fmt.Sprintf("%s [%s]", location, name)
+		s.Properties[name] = e.schema(paramLocation, fromQuery(properties.Validation), false)
+		if properties.InlineArray {
+			e.report(paramLocation, "inline array has no form body equivalent")
+		}
+	}
+	requiredProperties(s, schema.RequiredFields)
+	return &RequestBody{
+		Description: schema.Description,
+		Required:    true,
+		Content: map[string]MediaType{
+			"application/x-www-form-urlencoded": {
+				Schema: s,
+			},
+		},
+	}
+}
+
+func (e *exporter) multipart(location string, schema mbody.Schema) *RequestBody {
+	location += " multipart"
+	s := &Schema{
+		Type:                 Types{"object"},
+		Title:                schema.Title,
+		Description:          schema.Description,
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: false,
+	}
+	for _, name := range sortedKeys(schema.Parameters) {
+		properties := schema.Parameters[name]
+		paramLocation := fmt.Sprintf("%s [%s]", location, name)
+		s.Properties[name] = e.schema(paramLocation, fromQuery(properties.Validation), false)
+		if properties.InlineArray {
+			e.report(paramLocation, "inline array has no multipart body equivalent")
+		}
+	}
+	for _, name := range sortedKeys(schema.Files) {
+		file := schema.Files[name]
+		fileLocation := fmt.Sprintf("%s file [%s]", location, name)
+		fs := &Schema{
+			Type:        Types{"string"},
+			Format:      "binary",
+			Description: file.Description,
+		}
+		if len(file.ContentTypes) == 1 {
+			fs.ContentMediaType = file.ContentTypes[0]
+		}
+		if file.MaxCount != 1 {
+			fs = &Schema{
+				Type:  Types{"array"},
+				Items: fs,
+			}
+			if file.MaxCount > 1 {
+				maxItems := file.MaxCount
+				fs.MaxItems = &maxItems
+			}
+		}
+		s.Properties[name] = fs
+		if file.MaxSize > 0 || len(file.ContentTypes) > 1 || len(file.Extensions) > 0 || len(file.SniffedContentTypes) > 0 {
+			e.report(fileLocation, "file size, content type, extension and sniffing rules have no equivalent")
+		}
+	}
+	requiredProperties(s, schema.RequiredFields)
+	return &RequestBody{
+		Description: schema.Description,
+		Required:    true,
+		Content: map[string]MediaType{
+			"multipart/form-data": {
+				Schema: s,
+			},
+		},
+	}
+}
+
+func (e *exporter) xml(location string, schema xbody.Schema) *RequestBody {
+	body := e.body(location+" xml", jbody.Schema{
+		Title:       schema.Title,
+		Description: schema.Description,
+		Body:        schema.Body,
+	})
+	if body == nil {
+		return nil
+	}
+	s := body.Content["application/json"].Schema
+	if len(schema.Root) > 0 {
+		s.XML = &XML{
+			Name: schema.Root,
+		}
+	}
+	xmlAttributes(s)
+	body.Content = map[string]MediaType{
+		"application/xml": {
+			Schema: s,
+		},
+	}
+	return body
+}
+
+// content exports a request body content entry for each of the media types,
+// the media type of each body helper is replaced by the content media type.
+func (e *exporter) content(location string, content request.Content) *RequestBody {
+	location += " content"
+	if len(content.Default) > 0 {
+		e.report(location, "default media type [%s] has no equivalent", content.Default)
+	}
+	rb := &RequestBody{
+		Required: true,
+		Content:  map[string]MediaType{},
+	}
+	for _, mediaType := range sortedKeys(content.MediaTypes) {
+		cb := content.MediaTypes[mediaType]
+		mtLocation := fmt.Sprintf("%s [%s]", location, mediaType)
+		var body *RequestBody
+		switch {
+		case cb.Body != nil:
+			body = e.body(mtLocation, *cb.Body)
+		case cb.Form != nil:
+			body = e.form(mtLocation, *cb.Form)
+		case cb.Multipart != nil:
+			body = e.multipart(mtLocation, *cb.Multipart)
+		case cb.XML != nil:
+			body = e.xml(mtLocation, *cb.XML)
+		default:
+			e.report(mtLocation, "content body requires a body, form, multipart or xml schema")
+		}
+		if body == nil {
+			continue
+		}
+		for _, m := range body.Content {
+			rb.Content[mediaType] = m
+		}
+	}
+	if len(rb.Content) == 0 {
+		return nil
+	}
+	return rb
+}
+
+// xmlAttributes names the @ prefixed properties as XML attributes.
+func xmlAttributes(s *Schema) {
+	if s == nil {
+		return
+	}
+	for _, name := range sortedKeys(s.Properties) {
+		property := s.Properties[name]
+		if !strings.HasPrefix(name, xbody.AttributePrefix) {
+			xmlAttributes(property)
+			continue
+		}
+		delete(s.Properties, name)
+		property.XML = &XML{
+			Attribute: true,
+		}
+		s.Properties[strings.TrimPrefix(name, xbody.AttributePrefix)] = property
+	}
+	rename := func(required []string) []string {
+		renamed := make([]string, len(required))
+		for i, r := range required {
+			renamed[i] = strings.TrimPrefix(r, xbody.AttributePrefix)
+		}
+		return renamed
+	}
+	if s.Required != nil {
+		s.Required = rename(s.Required)
+	}
+	for _, anyOf := range s.AnyOf {
+		anyOf.Required = rename(anyOf.Required)
+	}
+	if s.DependentRequired != nil {
+		dependent := make(map[string][]string, len(s.DependentRequired))
+		for name, required := range s.DependentRequired {
+			dependent[strings.TrimPrefix(name, xbody.AttributePrefix)] = rename(required)
+		}
+		s.DependentRequired = dependent
+	}
+	xmlAttributes(s.Items)
+}
+
+// requiredProperties sets the object schema required properties, more than
+// one combination is any of.
+func requiredProperties(s *Schema, required field.Required) {
+	switch len(required.OneOf) {
+	case 0:
+	case 1:
+		s.Required = required.OneOf[0]
+	default:
+		for _, r := range required.OneOf {
+			s.AnyOf = append(s.AnyOf, &Schema{
+				Required: r,
+			})
+		}
+	}
+	if len(required.Present) > 0 {
+		s.DependentRequired = required.Present
+	}
+}
+
+// schema converts the validator, non empty is set when the validator rejects
+// empty strings.
+func (e *exporter) schema(location string, v validators, nonEmpty bool) *Schema {
+	switch {
+	case v.String != nil:
+		s := &Schema{
+			Type:    Types{"string"},
+			Pattern: v.String.RegEx,
+			Enum:    anySlice(v.String.OneOf),
+		}
+		if v.String.Value != nil {
+			s.Const = *v.String.Value
+		}
+		if nonEmpty {
+			minLength := 1
+			s.MinLength = &minLength
+		}
+		return s
+	case v.Number != nil:
+		s := &Schema{
+			Type:    Types{"number"},
+			Minimum: v.Number.Min,
+			Maximum: v.Number.Max,
+			Enum:    anySlice(v.Number.OneOf),
+		}
+		if v.Number.Value != nil {
+			s.Const = *v.Number.Value
+		}
+		return s
+	case v.Time != nil:
+		s := e.time(location, v.Time.Format, v.Time.Before, v.Time.After)
+		if v.Time.Value != nil {
+			s.Const = *v.Time.Value
+		}
+		return s
+	case v.Boolean != nil:
+		s := &Schema{
+			Type: Types{"boolean"},
+		}
+		if v.Boolean.Value != nil {
+			s.Const = *v.Boolean.Value
+		}
+		return s
+	case v.StringArray != nil:
+		s := &Schema{
+			Type: Types{"array"},
+			Items: &Schema{
+				Type:    Types{"string"},
+				Pattern: v.StringArray.RegEx,
+			},
+		}
+		e.array(s, anySlice(v.StringArray.Values), anySlice(v.StringArray.Present))
+		return s
+	case v.NumberArray != nil:
+		s := &Schema{
+			Type: Types{"array"},
+			Items: &Schema{
+				Type:    Types{"number"},
+				Minimum: v.NumberArray.Min,
+				Maximum: v.NumberArray.Max,
+			},
+		}
+		e.array(s, anySlice(v.NumberArray.Values), anySlice(v.NumberArray.Present))
+		return s
+	case v.TimeArray != nil:
+		s := &Schema{
+			Type:  Types{"array"},
+			Items: e.time(location+"[]", v.TimeArray.Format, v.TimeArray.Before, v.TimeArray.After),
+		}
+		e.array(s, anySlice(v.TimeArray.Values), nil)
+		return s
+	case v.Object != nil:
+		return e.object(location, *v.Object)
+	case v.ObjectArray != nil:
+		return &Schema{
+			Type:  Types{"array"},
+			Items: e.object(location+"[]", v.ObjectArray.Object),
+		}
+	default:
+		e.report(location, "validation is not present")
+		return &Schema{}
+	}
+}
+
+func (e *exporter) time(location, layout string, before, after *string) *Schema {
+	s := &Schema{
+		Type: Types{"string"},
+	}
+	for format, l := range timeFormats {
+		if l == layout {
+			s.Format = format
+		}
+	}
+	if len(s.Format) == 0 {
+		e.report(location, "time format [%s] has no format equivalent", layout)
+	}
+	if before != nil || after != nil {
+		e.report(location, "time before and after have no equivalent")
+	}
+	return s
+}
+
+// array sets the exact array values and the values that must be present.
+func (e *exporter) array(s *Schema, values, present []any) {
+	if len(values) > 0 {
+		s.Const = values
+	}
+	for _, p := range present {
+		s.AllOf = append(s.AllOf, &Schema{
+			Contains: &Schema{
+				Const: p,
+			},
+		})
+	}
+}
+
+func fromVariable(v endpoint.VariableValidation) validators {
+	vs := validators{}
+	if v.String != nil {
+		vs.String = &v.String.StringValidator
+	}
+	if v.Number != nil {
+		vs.Number = &v.Number.NumberValidator
+	}
+	return vs
+}
+
+func fromQuery(v query.ParameterValidation) validators {
+	vs := validators{
+		String:      v.String,
+		Time:        v.Time,
+		StringArray: v.StringArray,
+		TimeArray:   v.TimeArray,
+	}
+	if v.Number != nil {
+		vs.Number = &v.Number.NumberValidator
+	}
+	if v.Boolean != nil {
+		vs.Boolean = &v.Boolean.BooleanValidator
+	}
+	if v.NumberArray != nil {
+		vs.NumberArray = &v.NumberArray.NumberArrayValidator
+	}
+	return vs
+}
+
+func fromHeader(v header.ParameterValidation) validators {
+	return validators{
+		String:      v.String,
+		Number:      v.Number,
+		Time:        v.Time,
+		Boolean:     v.Boolean,
+		StringArray: v.StringArray,
+		NumberArray: v.NumberArray,
+		TimeArray:   v.TimeArray,
+	}
+}
+
+func fromCookie(v cookie.ParameterValidation) validators {
+	return validators{
+		String:      v.String,
+		Number:      v.Number,
+		Time:        v.Time,
+		Boolean:     v.Boolean,
+		StringArray: v.StringArray,
+		NumberArray: v.NumberArray,
+		TimeArray:   v.TimeArray,
+	}
+}
+
+func fromJBody(v jbody.ParameterValidation) validators {
+	vs := validators{
+		Object:      v.Object,
+		ObjectArray: v.ObjectArray,
+	}
+	if v.String != nil {
+		vs.String = &v.String.StringValidator
+	}
+	if v.Number != nil {
+		vs.Number = &v.Number.NumberValidator
+	}
+	if v.Time != nil {
+		vs.Time = &v.Time.TimeValidator
+	}
+	if v.Boolean != nil {
+		vs.Boolean = &v.Boolean.BooleanValidator
+	}
+	if v.StringArray != nil {
+		vs.StringArray = &v.StringArray.StringArrayValidator
+	}
+	if v.NumberArray != nil {
+		vs.NumberArray = &v.NumberArray.NumberArrayValidator
+	}
+	if v.TimeArray != nil {
+		vs.TimeArray = &v.TimeArray.TimeArrayValidator
+	}
+	return vs
+}
+
+func anySlice[T any](values []T) []any {
+	if len(values) == 0 {
+		return nil
+	}
+	s := make([]any, len(values))
+	for i, v := range values {
+		s[i] = v
+	}
+	return s
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
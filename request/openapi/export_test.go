@@ -0,0 +1,397 @@
+package openapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request"
+)
+
+const testRequestSchemaJSON = `{
+	"title": "create address",
+	"description": "creates an address for the user",
+	"endpoint": {
+		"method": "POST",
+		"endpoint": "/users/{id}/addresses",
+		"path_variables": {
+			"{id}": {
+				"validation": {
+					"number_validator": {"min": 1}
+				}
+			}
+		}
+	},
+	"query": {
+		"required_fields": {
+			"one_of": [["mode"]]
+		},
+		"parameters": {
+			"mode": {
+				"description": "create mode",
+				"example": "strict",
+				"validation": {
+					"string_validator": {"one_of": ["strict", "lenient"]}
+				}
+			},
+			"tags": {
+				"inline_array": true,
+				"inline_array_seperator": "|",
+				"validation": {
+					"string_array_validator": {"regex": "^[a-z]+$"}
+				}
+			},
+			"since": {
+				"validation": {
+					"time_validator": {"format": "2006-01-02", "before": "2030-01-01"}
+				}
+			}
+		}
+	},
+	"body": {
+		"title": "address",
+		"description": "the address",
+		"body": {
+			"object": {
+				"required_fields": {
+					"one_of": [
+						["street_1", "city", "state"],
+						["street_1", "zip"]
+					],
+					"present": {
+						"street_2": ["street_1"]
+					}
+				},
+				"parameters": {
+					"street_1": {"validation": {"string_validator": {}}},
+					"street_2": {"validation": {"string_validator": {}}},
+					"city": {"validation": {"string_validator": {}}},
+					"state": {"validation": {"string_validator": {"regex": "^[A-Z]{2}$"}}},
+					"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}},
+					"residents": {
+						"validation": {
+							"object_array_validator": {
+								"object": {
+									"required_fields": {"one_of": [["name"]]},
+									"parameters": {
+										"name": {"validation": {"string_validator": {}}}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestExport(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(testRequestSchemaJSON))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	doc, unsupported, err := Export(Info{Title: "Users", Version: "1.0.0"}, schema)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if doc.OpenAPI != Version {
+		t.Errorf("Export() version = %s, want %s", doc.OpenAPI, Version)
+	}
+	item, has := doc.Paths["/users/{id}/addresses"]
+	if !has || item.Post == nil {
+		t.Fatalf("Export() operation not present %v", doc.Paths)
+	}
+	op := item.Post
+	if op.Summary != "create address" || op.Description != "creates an address for the user" {
+		t.Errorf("Export() summary = %s, description = %s", op.Summary, op.Description)
+	}
+
+	params := map[string]Parameter{}
+	for _, p := range op.Parameters {
+		params[p.In+":"+p.Name] = p
+	}
+	mode := params["query:mode"]
+	if !mode.Required || mode.Example != "strict" || !reflect.DeepEqual(mode.Schema.Enum, []any{"strict", "lenient"}) {
+		t.Errorf("Export() mode parameter = %+v", mode)
+	}
+	tags := params["query:tags"]
+	if tags.Style != "pipeDelimited" || tags.Schema.Items == nil || *tags.Schema.Items.Pattern != "^[a-z]+$" {
+		t.Errorf("Export() tags parameter = %+v", tags)
+	}
+	id := params["path:id"]
+	if !id.Required || *id.Schema.Minimum != 1 {
+		t.Errorf("Export() id parameter = %+v", id)
+	}
+
+	body := op.RequestBody.Content["application/json"].Schema
+	if len(body.AnyOf) != 2 || !reflect.DeepEqual(body.AnyOf[1].Required, []string{"street_1", "zip"}) {
+		t.Errorf("Export() body any of = %v", body.AnyOf)
+	}
+	if !reflect.DeepEqual(body.DependentRequired, map[string][]string{"street_2": {"street_1"}}) {
+		t.Errorf("Export() body dependent required = %v", body.DependentRequired)
+	}
+	if body.AdditionalProperties != false {
+		t.Errorf("Export() body additional properties = %v", body.AdditionalProperties)
+	}
+	if residents := body.Properties["residents"]; residents.Items == nil || !reflect.DeepEqual(residents.Items.Required, []string{"name"}) {
+		t.Errorf("Export() residents = %+v", residents)
+	}
+
+	wantUnsupported := []Unsupported{
+		{
+			Location: "POST /users/{id}/addresses query [since]",
+			Msg:      "time before and after have no equivalent",
+		},
+	}
+	if !reflect.DeepEqual(unsupported, wantUnsupported) {
+		t.Errorf("Export() unsupported = %v, want %v", unsupported, wantUnsupported)
+	}
+}
+
+func TestExport_Duplicate(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(testRequestSchemaJSON))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	if _, _, err := Export(Info{}, schema, schema); err == nil {
+		t.Errorf("Export() expected a duplicate operation error")
+	}
+	if _, _, err := Export(Info{}, request.Schema{}); err == nil {
+		t.Errorf("Export() expected an endpoint error")
+	}
+}
+
+func TestExportJSON_Import(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(testRequestSchemaJSON))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	buf := &bytes.Buffer{}
+	if _, err := ExportJSON(buf, Info{Title: "Users", Version: "1.0.0"}, schema); err != nil {
+		t.Fatalf("ExportJSON() error = %v", err)
+	}
+	operations, _, err := ImportJSON(buf)
+	if err != nil {
+		t.Fatalf("ImportJSON() error = %v", err)
+	}
+	if len(operations) != 1 {
+		t.Fatalf("ImportJSON() operations = %d, want 1", len(operations))
+	}
+	imported := operations[0].Schema
+
+	tests := []struct {
+		name    string
+		path    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			path:    "/users/10/addresses?mode=strict&tags=home|work",
+			body:    `{"street_1": "123 Main St", "zip": "12345", "residents": [{"name": "Gary"}]}`,
+			wantErr: false,
+		},
+		{
+			name:    "fail: required combination",
+			path:    "/users/10/addresses?mode=strict",
+			body:    `{"street_1": "123 Main St", "city": "Springfield"}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: query",
+			path:    "/users/10/addresses?mode=other",
+			body:    `{"street_1": "123 Main St", "zip": "12345"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range []request.Schema{schema, imported} {
+				req := httptest.NewRequest(http.MethodPost, "http://www.test.com"+tt.path, strings.NewReader(tt.body))
+				if err := s.Validate(req); (err != nil) != tt.wantErr {
+					t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestExport_Form(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(`{
+		"endpoint": {"method": "POST", "endpoint": "/token"},
+		"form": {
+			"required_fields": {"one_of": [["grant_type"]]},
+			"parameters": {
+				"grant_type": {"validation": {"string_validator": {"one_of": ["client_credentials"]}}},
+				"scope": {"inline_array": true, "inline_array_seperator": " ", "validation": {"string_array_validator": {}}}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	doc, unsupported, err := Export(Info{Title: "Token", Version: "1.0.0"}, schema)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	op := doc.Paths["/token"].Post
+	if op == nil || op.RequestBody == nil {
+		t.Fatalf("Export() operation request body not present %v", doc.Paths)
+	}
+	body := op.RequestBody.Content["application/x-www-form-urlencoded"].Schema
+	if body == nil || !reflect.DeepEqual(body.Required, []string{"grant_type"}) || len(body.Properties) != 2 {
+		t.Errorf("Export() form body = %+v", body)
+	}
+	wantUnsupported := []Unsupported{
+		{
+			Location: "POST /token form [scope]",
+			Msg:      "inline array has no form body equivalent",
+		},
+	}
+	if !reflect.DeepEqual(unsupported, wantUnsupported) {
+		t.Errorf("Export() unsupported = %v, want %v", unsupported, wantUnsupported)
+	}
+}
+
+func TestExport_Multipart(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(`{
+		"endpoint": {"method": "POST", "endpoint": "/avatars"},
+		"multipart": {
+			"required_fields": {"one_of": [["avatar"]]},
+			"parameters": {
+				"name": {"validation": {"string_validator": {}}}
+			},
+			"files": {
+				"avatar": {"max_count": 1, "content_types": ["image/png"]},
+				"imports": {"max_count": 3, "extensions": [".csv"]}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	doc, unsupported, err := Export(Info{Title: "Avatars", Version: "1.0.0"}, schema)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	op := doc.Paths["/avatars"].Post
+	if op == nil || op.RequestBody == nil {
+		t.Fatalf("Export() operation request body not present %v", doc.Paths)
+	}
+	body := op.RequestBody.Content["multipart/form-data"].Schema
+	if body == nil || !reflect.DeepEqual(body.Required, []string{"avatar"}) {
+		t.Fatalf("Export() multipart body = %+v", body)
+	}
+	if avatar := body.Properties["avatar"]; avatar.Format != "binary" || avatar.ContentMediaType != "image/png" {
+		t.Errorf("Export() avatar = %+v", avatar)
+	}
+	if imports := body.Properties["imports"]; imports.Items == nil || *imports.MaxItems != 3 {
+		t.Errorf("Export() imports = %+v", imports)
+	}
+	wantUnsupported := []Unsupported{
+		{
+			Location: "POST /avatars multipart file [imports]",
+			Msg:      "file size, content type, extension and sniffing rules have no equivalent",
+		},
+	}
+	if !reflect.DeepEqual(unsupported, wantUnsupported) {
+		t.Errorf("Export() unsupported = %v, want %v", unsupported, wantUnsupported)
+	}
+}
+
+func TestExport_XML(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(`{
+		"endpoint": {"method": "POST", "endpoint": "/orders"},
+		"xml": {
+			"root": "order",
+			"body": {
+				"object": {
+					"required_fields": {"one_of": [["@id", "item"]]},
+					"parameters": {
+						"@id": {"validation": {"number_validator": {"min": 1}}},
+						"item": {"validation": {"string_validator": {}}}
+					}
+				}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	doc, _, err := Export(Info{Title: "Orders", Version: "1.0.0"}, schema)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	op := doc.Paths["/orders"].Post
+	if op == nil || op.RequestBody == nil {
+		t.Fatalf("Export() operation request body not present %v", doc.Paths)
+	}
+	body := op.RequestBody.Content["application/xml"].Schema
+	if body == nil || body.XML == nil || body.XML.Name != "order" {
+		t.Fatalf("Export() xml body = %+v", body)
+	}
+	if id := body.Properties["id"]; id == nil || id.XML == nil || !id.XML.Attribute {
+		t.Errorf("Export() id attribute = %+v", id)
+	}
+	if !reflect.DeepEqual(body.Required, []string{"id", "item"}) {
+		t.Errorf("Export() required = %v", body.Required)
+	}
+	if !reflect.DeepEqual(schema.XML.Body.Object.RequiredFields.OneOf[0], []string{"@id", "item"}) {
+		t.Errorf("Export() changed the schema required fields %v", schema.XML.Body.Object.RequiredFields.OneOf)
+	}
+}
+
+func TestExport_Content(t *testing.T) {
+	schema, err := request.SchemaFromJSON(strings.NewReader(`{
+		"endpoint": {"method": "POST", "endpoint": "/users"},
+		"content": {
+			"default": "application/json",
+			"media_types": {
+				"application/json": {
+					"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}
+				},
+				"application/x-www-form-urlencoded": {
+					"form": {"parameters": {"name": {"validation": {"string_validator": {}}}}}
+				},
+				"text/*": {
+					"xml": {"root": "user", "body": {"object": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}}
+				}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("request.SchemaFromJSON() error = %v", err)
+	}
+	doc, unsupported, err := Export(Info{Title: "Users", Version: "1.0.0"}, schema)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	op := doc.Paths["/users"].Post
+	if op == nil || op.RequestBody == nil {
+		t.Fatalf("Export() operation request body not present %v", doc.Paths)
+	}
+	for _, mediaType := range []string{"application/json", "application/x-www-form-urlencoded", "text/*"} {
+		if body := op.RequestBody.Content[mediaType].Schema; body == nil || body.Properties["name"] == nil {
+			t.Errorf("Export() content [%s] body = %+v", mediaType, body)
+		}
+	}
+	if body := op.RequestBody.Content["text/*"].Schema; body.XML == nil || body.XML.Name != "user" {
+		t.Errorf("Export() content xml body = %+v", body)
+	}
+	if len(op.RequestBody.Content) != 3 {
+		t.Errorf("Export() content = %v", op.RequestBody.Content)
+	}
+	wantUnsupported := []Unsupported{
+		{
+			Location: "POST /users content",
+			Msg:      "default media type [application/json] has no equivalent",
+		},
+	}
+	if !reflect.DeepEqual(unsupported, wantUnsupported) {
+		t.Errorf("Export() unsupported = %v, want %v", unsupported, wantUnsupported)
+	}
+}
@@ -0,0 +1,897 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/g8rswimmer/httpx/request"
+	"github.com/g8rswimmer/httpx/request/cookie"
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/header"
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/query"
+)
+
+const (
+	schemaRefPrefix      = "#/components/schemas/"
+	parameterRefPrefix   = "#/components/parameters/"
+	requestBodyRefPrefix = "#/components/requestBodies/"
+)
+
+var (
+	methods = []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH", "TRACE"}
+
+	timeFormats = map[string]string{
+		"date-time": time.RFC3339,
+		"date":      "2006-01-02",
+	}
+)
+
+type Unsupported struct {
+	Location string `json:"location"`
+	Msg      string `json:"message"`
+}
+
+type ImportedOperation struct {
+	OperationID string         `json:"operation_id"`
+	Method      string         `json:"method"`
+	Path        string         `json:"path"`
+	Schema      request.Schema `json:"schema"`
+}
+
+func ImportJSON(reader io.Reader) ([]ImportedOperation, []Unsupported, error) {
+	var doc Document
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("openapi decode json: %w", err)
+	}
+	return Import(doc)
+}
+
+// Import converts every operation of the document into a request schema. The
+// constructs that can not be expressed by the schemas are returned as
+// unsupported, operations that can not be converted at all are skipped.
+func Import(doc Document) ([]ImportedOperation, []Unsupported, error) {
+	if !strings.HasPrefix(doc.OpenAPI, "3.0") && !strings.HasPrefix(doc.OpenAPI, "3.1") {
+		return nil, nil, fmt.Errorf("openapi version [%s] is not supported", doc.OpenAPI)
+	}
+	i := &importer{
+		doc:  doc,
+		refs: map[string]struct{}{},
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	operations := []ImportedOperation{}
+	for _, path := range paths {
+		item := doc.Paths[path]
+		itemOperations := item.Operations()
+		for _, method := range methods {
+			op, has := itemOperations[method]
+			if !has {
+				continue
+			}
+			if imported, ok := i.operation(method, path, item, op); ok {
+				operations = append(operations, imported)
+			}
+		}
+	}
+	return operations, i.unsupported, nil
+}
+
+type importer struct {
+	doc         Document
+	refs        map[string]struct{}
+	unsupported []Unsupported
+}
+
+func (i *importer) report(location string, format string, args ...any) {
+	i.unsupported = append(i.unsupported, Unsupported{
+		Location: location,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (i *importer) operation(method, path string, item PathItem, op *Operation) (ImportedOperation, bool) {
+	location := method + " " + path
+	params := i.parameters(location, item.Parameters, op.Parameters)
+
+	ep, ok := i.endpoint(location, method, path, op, params)
+	if !ok {
+		return ImportedOperation{}, false
+	}
+	schema := request.Schema{
+		Title:       op.Summary,
+		Description: op.Description,
+		Endpoint:    ep,
+		Query:       i.query(location, op, params),
+		Header:      i.header(location, op, params),
+		Cookie:      i.cookie(location, op, params),
+		Body:        i.body(location, op),
+	}
+	if err := request.SchemaModelValidator(schema); err != nil {
+		i.report(location, "operation skipped: %v", err)
+		return ImportedOperation{}, false
+	}
+	id := op.OperationID
+	if len(id) == 0 {
+		id = location
+	}
+	return ImportedOperation{
+		OperationID: id,
+		Method:      method,
+		Path:        path,
+		Schema:      schema,
+	}, true
+}
+
+// parameters resolves the path item and operation parameters, the operation
+// parameters override the path item parameters with the same name and location.
+func (i *importer) parameters(location string, lists ...[]Parameter) []Parameter {
+	merged := []Parameter{}
+	index := map[string]int{}
+	for _, list := range lists {
+		for _, p := range list {
+			if len(p.Ref) > 0 {
+				target, has := i.doc.Components.Parameters[strings.TrimPrefix(p.Ref, parameterRefPrefix)]
+				if !strings.HasPrefix(p.Ref, parameterRefPrefix) || !has || len(target.Ref) > 0 {
+					i.report(location, "parameter reference [%s] not resolved", p.Ref)
+					continue
+				}
+				p = target
+			}
+			key := p.In + ":" + p.Name
+			if idx, has := index[key]; has {
+				merged[idx] = p
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, p)
+		}
+	}
+	return merged
+}
+
+func (i *importer) endpoint(location, method, path string, op *Operation, params []Parameter) (*endpoint.Schema, bool) {
+	declared := map[string]Parameter{}
+	for _, p := range params {
+		if p.In == "path" {
+			declared[p.Name] = p
+		}
+	}
+	variables := map[string]endpoint.PathVariable{}
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.ContainsAny(segment, "{}") {
+			continue
+		}
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") || strings.Count(segment, "{") != 1 {
+			i.report(location, "operation skipped: path template segment [%s] not supported", segment)
+			return nil, false
+		}
+		name := segment[1 : len(segment)-1]
+		paramLocation := fmt.Sprintf("%s path [%s]", location, name)
+		p, has := declared[name]
+		delete(declared, name)
+		if !has {
+			i.report(paramLocation, "path parameter not declared, validated as a string")
+			variables[segment] = endpoint.PathVariable{
+				Validation: endpoint.VariableValidation{
+					String: &endpoint.StringValidator{},
+				},
+			}
+			continue
+		}
+		def := i.parameterDefinition(paramLocation, p)
+		validation, ok := def.validators.variable()
+		if !ok {
+			i.report(paramLocation, "path parameter type not supported, validated as a string")
+			validation = endpoint.VariableValidation{
+				String: &endpoint.StringValidator{},
+			}
+		}
+		variables[segment] = endpoint.PathVariable{
+			Validation: validation,
+		}
+	}
+	for _, p := range params {
+		if _, has := declared[p.Name]; has && p.In == "path" {
+			i.report(fmt.Sprintf("%s path [%s]", location, p.Name), "path parameter not in the path template")
+		}
+	}
+	schema := &endpoint.Schema{
+		Title:       op.Summary,
+		Description: op.Description,
+		Method:      method,
+		Endpoint:    path,
+	}
+	if len(variables) > 0 {
+		schema.PathVariables = variables
+	}
+	return schema, true
+}
+
+func (i *importer) query(location string, op *Operation, params []Parameter) *query.Schema {
+	parameters := map[string]query.ParameterProperties{}
+	required := []string{}
+	for _, p := range params {
+		if p.In != "query" {
+			continue
+		}
+		def := i.parameterDefinition(fmt.Sprintf("%s query [%s]", location, p.Name), p)
+		parameters[p.Name] = query.ParameterProperties{
+			Description:          def.description,
+			Example:              def.example,
+			InlineArray:          def.inlineArray,
+			InlineArraySeperator: def.separator,
+			Validation:           def.validators.query(),
+		}
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+	if len(parameters) == 0 {
+		return nil
+	}
+	return &query.Schema{
+		Title:          op.Summary,
+		Description:    op.Description,
+		RequiredFields: oneOf(required),
+		Parameters:     parameters,
+	}
+}
+
+func (i *importer) header(location string, op *Operation, params []Parameter) *header.Schema {
+	parameters := map[string]header.ParameterProperties{}
+	required := []string{}
+	for _, p := range params {
+		if p.In != "header" {
+			continue
+		}
+		// the specification ignores these header parameters
+		switch strings.ToLower(p.Name) {
+		case "accept", "content-type", "authorization":
+			continue
+		default:
+		}
+		def := i.parameterDefinition(fmt.Sprintf("%s header [%s]", location, p.Name), p)
+		parameters[p.Name] = header.ParameterProperties{
+			Description:          def.description,
+			Example:              def.example,
+			InlineArray:          def.inlineArray,
+			InlineArraySeperator: def.separator,
+			Validation:           def.validators.header(),
+		}
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+	if len(parameters) == 0 {
+		return nil
+	}
+	return &header.Schema{
+		Title:          op.Summary,
+		Description:    op.Description,
+		RequiredFields: oneOf(required),
+		Parameters:     parameters,
+	}
+}
+
+func (i *importer) cookie(location string, op *Operation, params []Parameter) *cookie.Schema {
+	parameters := map[string]cookie.ParameterProperties{}
+	required := []string{}
+	for _, p := range params {
+		if p.In != "cookie" {
+			continue
+		}
+		def := i.parameterDefinition(fmt.Sprintf("%s cookie [%s]", location, p.Name), p)
+		parameters[p.Name] = cookie.ParameterProperties{
+			Description:          def.description,
+			Example:              def.example,
+			InlineArray:          def.inlineArray,
+			InlineArraySeperator: def.separator,
+			Validation:           def.validators.cookie(),
+		}
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+	if len(parameters) == 0 {
+		return nil
+	}
+	return &cookie.Schema{
+		Title:          op.Summary,
+		Description:    op.Description,
+		RequiredFields: oneOf(required),
+		Parameters:     parameters,
+	}
+}
+
+func (i *importer) body(location string, op *Operation) *jbody.Schema {
+	if op.RequestBody == nil {
+		return nil
+	}
+	location += " body"
+	rb := *op.RequestBody
+	if len(rb.Ref) > 0 {
+		target, has := i.doc.Components.RequestBodies[strings.TrimPrefix(rb.Ref, requestBodyRefPrefix)]
+		if !strings.HasPrefix(rb.Ref, requestBodyRefPrefix) || !has || len(target.Ref) > 0 {
+			i.report(location, "request body reference [%s] not resolved", rb.Ref)
+			return nil
+		}
+		rb = target
+	}
+
+	mediaTypes := make([]string, 0, len(rb.Content))
+	for mediaType := range rb.Content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+
+	var media *MediaType
+	for _, mediaType := range mediaTypes {
+		mt, _, err := mime.ParseMediaType(mediaType)
+		switch {
+		case err == nil && media == nil && (mt == "application/json" || strings.HasSuffix(mt, "+json")):
+			m := rb.Content[mediaType]
+			media = &m
+		default:
+			i.report(location, "request body media type [%s] not supported", mediaType)
+		}
+	}
+	if media == nil {
+		return nil
+	}
+	if !rb.Required {
+		i.report(location, "optional request body is validated as required")
+	}
+	v, ok := i.validators(location, media.Schema)
+	if !ok {
+		return nil
+	}
+	body := jbody.Body{
+		Object:      v.Object,
+		ObjectArray: v.ObjectArray,
+	}
+	if body.Object == nil && body.ObjectArray == nil {
+		i.report(location, "request body must be an object or an object array")
+		return nil
+	}
+	return &jbody.Schema{
+		Title:       op.Summary,
+		Description: rb.Description,
+		Body:        body,
+	}
+}
+
+type parameterDefinition struct {
+	description string
+	example     string
+	inlineArray bool
+	separator   string
+	validators  validators
+}
+
+func (i *importer) parameterDefinition(location string, p Parameter) parameterDefinition {
+	def := parameterDefinition{
+		description: p.Description,
+		example:     example(p.Example),
+	}
+	if p.Schema == nil {
+		i.report(location, "parameter content not supported, validated as a string")
+		def.validators = validators{
+			String: &parameter.StringValidator{},
+		}
+		return def
+	}
+	if len(def.example) == 0 {
+		def.example = example(p.Schema.Example)
+	}
+	v, ok := i.validators(location, p.Schema)
+	switch {
+	case !ok:
+		i.report(location, "parameter validated as a string")
+		v = validators{
+			String: &parameter.StringValidator{},
+		}
+	case v.Object != nil || v.ObjectArray != nil:
+		i.report(location, "object parameter not supported, validated as a string")
+		v = validators{
+			String: &parameter.StringValidator{},
+		}
+	default:
+	}
+	if v.StringArray != nil || v.NumberArray != nil || v.TimeArray != nil {
+		def.inlineArray = true
+		def.separator = i.separator(location, p)
+	}
+	def.validators = v
+	return def
+}
+
+func (i *importer) separator(location string, p Parameter) string {
+	style := p.Style
+	if len(style) == 0 {
+		switch p.In {
+		case "query", "cookie":
+			style = "form"
+		default:
+			style = "simple"
+		}
+	}
+	switch style {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	case "simple":
+		return ","
+	case "form":
+		if p.Explode == nil || *p.Explode {
+			i.report(location, "exploded array parameter not supported, validated as comma separated")
+		}
+		return ","
+	default:
+		i.report(location, "parameter style [%s] not supported, validated as comma separated", style)
+		return ","
+	}
+}
+
+type validators struct {
+	String      *parameter.StringValidator
+	Number      *parameter.NumberValidator
+	Time        *parameter.TimeValidator
+	Boolean     *parameter.BooleanValidator
+	StringArray *parameter.StringArrayValidator
+	NumberArray *parameter.NumberArrayValidator
+	TimeArray   *parameter.TimeArrayValidator
+	Object      *jbody.ObjectValidator
+	ObjectArray *jbody.ObjectArrayValidator
+}
+
+func (v validators) variable() (endpoint.VariableValidation, bool) {
+	switch {
+	case v.String != nil:
+		return endpoint.VariableValidation{
+			String: &endpoint.StringValidator{StringValidator: *v.String},
+		}, true
+	case v.Number != nil:
+		return endpoint.VariableValidation{
+			Number: &endpoint.NumberValidator{NumberValidator: *v.Number},
+		}, true
+	default:
+		return endpoint.VariableValidation{}, false
+	}
+}
+
+func (v validators) query() query.ParameterValidation {
+	pv := query.ParameterValidation{
+		String:      v.String,
+		Time:        v.Time,
+		StringArray: v.StringArray,
+		TimeArray:   v.TimeArray,
+	}
+	if v.Number != nil {
+		pv.Number = &query.NumberValidator{NumberValidator: *v.Number}
+	}
+	if v.Boolean != nil {
+		pv.Boolean = &query.BooleanValidator{BooleanValidator: *v.Boolean}
+	}
+	if v.NumberArray != nil {
+		pv.NumberArray = &query.NumberArrayValidator{NumberArrayValidator: *v.NumberArray}
+	}
+	return pv
+}
+
+func (v validators) header() header.ParameterValidation {
+	return header.ParameterValidation{
+		String:      v.String,
+		Number:      v.Number,
+		Time:        v.Time,
+		Boolean:     v.Boolean,
+		StringArray: v.StringArray,
+		TimeArray:   v.TimeArray,
+		NumberArray: v.NumberArray,
+	}
+}
+
+func (v validators) cookie() cookie.ParameterValidation {
+	return cookie.ParameterValidation{
+		String:      v.String,
+		Number:      v.Number,
+		Time:        v.Time,
+		Boolean:     v.Boolean,
+		StringArray: v.StringArray,
+		TimeArray:   v.TimeArray,
+		NumberArray: v.NumberArray,
+	}
+}
+
+func (v validators) jbody() jbody.ParameterValidation {
+	pv := jbody.ParameterValidation{
+		Object:      v.Object,
+		ObjectArray: v.ObjectArray,
+	}
+	if v.String != nil {
+		pv.String = &jbody.StringValidator{StringValidator: *v.String}
+	}
+	if v.Number != nil {
+		pv.Number = &jbody.NumberValidator{NumberValidator: *v.Number}
+	}
+	if v.Time != nil {
+		pv.Time = &jbody.TimeValidator{TimeValidator: *v.Time}
+	}
+	if v.Boolean != nil {
+		pv.Boolean = &jbody.BooleanValidator{BooleanValidator: *v.Boolean}
+	}
+	if v.StringArray != nil {
+		pv.StringArray = &jbody.StringArrayValidator{StringArrayValidator: *v.StringArray}
+	}
+	if v.NumberArray != nil {
+		pv.NumberArray = &jbody.NumberArrayValidator{NumberArrayValidator: *v.NumberArray}
+	}
+	if v.TimeArray != nil {
+		pv.TimeArray = &jbody.TimeArrayValidator{TimeArrayValidator: *v.TimeArray}
+	}
+	return pv
+}
+
+// resolve follows the schema references and single schema all of
+// compositions, the release function must be called once the resolved schema
+// has been converted.
+func (i *importer) resolve(location string, s *Schema) (*Schema, func()) {
+	pushed := []string{}
+	release := func() {
+		for _, ref := range pushed {
+			delete(i.refs, ref)
+		}
+	}
+	for s != nil {
+		switch {
+		case len(s.Ref) > 0:
+			if _, has := i.refs[s.Ref]; has {
+				i.report(location, "recursive schema reference [%s] not supported", s.Ref)
+				return nil, release
+			}
+			target, has := i.doc.Components.Schemas[strings.TrimPrefix(s.Ref, schemaRefPrefix)]
+			if !strings.HasPrefix(s.Ref, schemaRefPrefix) || !has {
+				i.report(location, "schema reference [%s] not resolved", s.Ref)
+				return nil, release
+			}
+			i.refs[s.Ref] = struct{}{}
+			pushed = append(pushed, s.Ref)
+			s = target
+		case len(s.AllOf) == 1 && len(s.Type) == 0 && len(s.Properties) == 0:
+			s = s.AllOf[0]
+		default:
+			return s, release
+		}
+	}
+	i.report(location, "schema is required")
+	return nil, release
+}
+
+func (i *importer) validators(location string, s *Schema) (validators, bool) {
+	s, release := i.resolve(location, s)
+	defer release()
+	if s == nil {
+		return validators{}, false
+	}
+	kind, ok := i.kind(location, s)
+	if !ok {
+		return validators{}, false
+	}
+	switch kind {
+	case "string":
+		if layout, has := timeFormats[s.Format]; has {
+			return validators{Time: i.time(location, s, layout)}, true
+		}
+		return validators{String: i.string(location, s)}, true
+	case "number", "integer":
+		return validators{Number: i.number(location, s, kind)}, true
+	case "boolean":
+		return validators{Boolean: i.boolean(location, s)}, true
+	case "object":
+		return validators{Object: i.object(location, s)}, true
+	case "array":
+		return i.array(location, s)
+	default:
+		i.report(location, "schema type [%s] not supported", kind)
+		return validators{}, false
+	}
+}
+
+func (i *importer) kind(location string, s *Schema) (string, bool) {
+	switch {
+	case len(s.OneOf) > 0:
+		i.report(location, "oneOf not supported")
+	case len(s.AnyOf) > 0 && !requiredOnly(s.AnyOf):
+		i.report(location, "anyOf not supported")
+	case len(s.AllOf) > 0:
+		i.report(location, "allOf not supported")
+	case s.Not != nil:
+		i.report(location, "not not supported")
+	default:
+	}
+	if s.Contains != nil {
+		i.report(location, "contains not supported")
+	}
+	kind, null, err := s.Type.Kind()
+	if err != nil {
+		i.report(location, "schema type: %v", err)
+		return "", false
+	}
+	if null || s.Nullable {
+		i.report(location, "null values not supported")
+	}
+	switch {
+	case len(kind) > 0:
+	case len(s.Properties) > 0:
+		kind = "object"
+	case s.Items != nil:
+		kind = "array"
+	default:
+		i.report(location, "schema type is required")
+		return "", false
+	}
+	return kind, true
+}
+
+func (i *importer) string(location string, s *Schema) *parameter.StringValidator {
+	v := &parameter.StringValidator{
+		RegEx: s.Pattern,
+	}
+	for _, e := range s.Enum {
+		str, ok := e.(string)
+		if !ok {
+			i.report(location, "enum value [%v] is not a string", e)
+			continue
+		}
+		v.OneOf = append(v.OneOf, str)
+	}
+	if s.Const != nil {
+		if str, ok := s.Const.(string); ok {
+			v.Value = &str
+		} else {
+			i.report(location, "const value [%v] is not a string", s.Const)
+		}
+	}
+	if s.MinLength != nil || s.MaxLength != nil {
+		i.report(location, "minLength and maxLength not supported")
+	}
+	if len(s.Format) > 0 {
+		i.report(location, "string format [%s] not supported", s.Format)
+	}
+	return v
+}
+
+func (i *importer) time(location string, s *Schema, layout string) *parameter.TimeValidator {
+	v := &parameter.TimeValidator{
+		Format: layout,
+	}
+	if s.Const != nil {
+		if str, ok := s.Const.(string); ok {
+			v.Value = &str
+		} else {
+			i.report(location, "const value [%v] is not a string", s.Const)
+		}
+	}
+	if len(s.Enum) > 0 {
+		i.report(location, "enum not supported for format [%s]", s.Format)
+	}
+	if s.Pattern != nil {
+		i.report(location, "pattern not supported for format [%s]", s.Format)
+	}
+	return v
+}
+
+func (i *importer) number(location string, s *Schema, kind string) *parameter.NumberValidator {
+	v := &parameter.NumberValidator{
+		Min: s.Minimum,
+		Max: s.Maximum,
+	}
+	for _, e := range s.Enum {
+		num, ok := e.(float64)
+		if !ok {
+			i.report(location, "enum value [%v] is not a number", e)
+			continue
+		}
+		v.OneOf = append(v.OneOf, num)
+	}
+	if s.Const != nil {
+		if num, ok := s.Const.(float64); ok {
+			v.Value = &num
+		} else {
+			i.report(location, "const value [%v] is not a number", s.Const)
+		}
+	}
+	if kind == "integer" {
+		i.report(location, "integer type validated as a number")
+	}
+	if isSet(s.ExclusiveMinimum) || isSet(s.ExclusiveMaximum) {
+		i.report(location, "exclusiveMinimum and exclusiveMaximum not supported")
+	}
+	if s.MultipleOf != nil {
+		i.report(location, "multipleOf not supported")
+	}
+	return v
+}
+
+func (i *importer) boolean(location string, s *Schema) *parameter.BooleanValidator {
+	v := &parameter.BooleanValidator{}
+	value := s.Const
+	if value == nil && len(s.Enum) == 1 {
+		value = s.Enum[0]
+	}
+	if value != nil {
+		if b, ok := value.(bool); ok {
+			v.Value = &b
+		} else {
+			i.report(location, "value [%v] is not a boolean", value)
+		}
+	}
+	return v
+}
+
+func (i *importer) array(location string, s *Schema) (validators, bool) {
+	if s.MinItems != nil || s.MaxItems != nil || s.UniqueItems {
+		i.report(location, "minItems, maxItems and uniqueItems not supported")
+	}
+	itemsLocation := location + "[]"
+	if s.Items == nil {
+		i.report(itemsLocation, "array items schema is required")
+		return validators{}, false
+	}
+	items, release := i.resolve(itemsLocation, s.Items)
+	defer release()
+	if items == nil {
+		return validators{}, false
+	}
+	kind, ok := i.kind(itemsLocation, items)
+	if !ok {
+		return validators{}, false
+	}
+	switch kind {
+	case "string":
+		if layout, has := timeFormats[items.Format]; has {
+			v := i.time(itemsLocation, items, layout)
+			if v.Value != nil {
+				i.report(itemsLocation, "array item const not supported")
+			}
+			return validators{
+				TimeArray: &parameter.TimeArrayValidator{
+					Format: v.Format,
+				},
+			}, true
+		}
+		v := i.string(itemsLocation, items)
+		if v.Value != nil || len(v.OneOf) > 0 {
+			i.report(itemsLocation, "array item enum and const not supported")
+		}
+		return validators{
+			StringArray: &parameter.StringArrayValidator{
+				RegEx: v.RegEx,
+			},
+		}, true
+	case "number", "integer":
+		v := i.number(itemsLocation, items, kind)
+		if v.Value != nil || len(v.OneOf) > 0 {
+			i.report(itemsLocation, "array item enum and const not supported")
+		}
+		return validators{
+			NumberArray: &parameter.NumberArrayValidator{
+				Min: v.Min,
+				Max: v.Max,
+			},
+		}, true
+	case "object":
+		return validators{
+			ObjectArray: &jbody.ObjectArrayValidator{
+				Object: *i.object(itemsLocation, items),
+			},
+		}, true
+	default:
+		i.report(itemsLocation, "array item type [%s] not supported", kind)
+		return validators{}, false
+	}
+}
+
+func (i *importer) object(location string, s *Schema) *jbody.ObjectValidator {
+	obj := &jbody.ObjectValidator{
+		Parameters: map[string]jbody.ParameterProperties{},
+	}
+	for _, name := range sortedKeys(s.Properties) {
+		propertyLocation := location + "." + name
+		v, ok := i.validators(propertyLocation, s.Properties[name])
+		if !ok {
+			i.report(propertyLocation, "property dropped")
+			continue
+		}
+		obj.Parameters[name] = jbody.ParameterProperties{
+			Validation: v.jbody(),
+		}
+	}
+	required := []string{}
+	for _, name := range s.Required {
+		if _, has := obj.Parameters[name]; !has {
+			i.report(location, "required property [%s] is not a converted property", name)
+			continue
+		}
+		required = append(required, name)
+	}
+	obj.RequiredFields = oneOf(required)
+	if len(s.AnyOf) > 0 && requiredOnly(s.AnyOf) {
+		if len(required) > 0 {
+			i.report(location, "required properties combined with anyOf required not supported")
+		}
+		obj.RequiredFields.OneOf = nil
+		for _, combination := range s.AnyOf {
+			obj.RequiredFields.OneOf = append(obj.RequiredFields.OneOf, combination.Required)
+		}
+	}
+	if len(s.DependentRequired) > 0 {
+		obj.RequiredFields.Present = s.DependentRequired
+	}
+	if len(s.Properties) == 0 {
+		i.report(location, "object without properties rejects every field")
+	}
+	if b, ok := s.AdditionalProperties.(bool); (ok && b) || (!ok && s.AdditionalProperties != nil) {
+		i.report(location, "additionalProperties not supported, unknown fields are rejected")
+	}
+	return obj
+}
+
+func oneOf(required []string) field.Required {
+	if len(required) == 0 {
+		return field.Required{}
+	}
+	return field.Required{
+		OneOf: [][]string{required},
+	}
+}
+
+// requiredOnly returns if the schemas only list required properties, which is
+// how the required field one of combinations are described.
+func requiredOnly(schemas []*Schema) bool {
+	for _, s := range schemas {
+		if len(s.Required) == 0 || len(s.Type) > 0 || len(s.Properties) > 0 || len(s.Ref) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isSet(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	default:
+		return true
+	}
+}
+
+func example(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		enc, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(enc)
+	}
+}
@@ -0,0 +1,295 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testOpenAPIJSON = `{
+	"openapi": "3.1.0",
+	"info": {
+		"title": "Users",
+		"version": "1.0.0"
+	},
+	"paths": {
+		"/users": {
+			"get": {
+				"operationId": "listUsers",
+				"summary": "list users",
+				"parameters": [
+					{
+						"name": "limit",
+						"in": "query",
+						"required": true,
+						"schema": {"type": "number", "minimum": 1, "maximum": 100},
+						"example": 10
+					},
+					{
+						"name": "status",
+						"in": "query",
+						"schema": {"type": "string", "enum": ["active", "inactive"]}
+					},
+					{
+						"name": "ids",
+						"in": "query",
+						"explode": false,
+						"schema": {"type": "array", "items": {"type": "number"}}
+					},
+					{
+						"name": "X-Request-ID",
+						"in": "header",
+						"required": true,
+						"schema": {"type": "string", "pattern": "^[a-z0-9-]+$"}
+					},
+					{
+						"name": "Accept",
+						"in": "header",
+						"schema": {"type": "string"}
+					}
+				]
+			},
+			"post": {
+				"operationId": "createUser",
+				"requestBody": {
+					"required": true,
+					"content": {
+						"application/json; charset=utf-8": {
+							"schema": {"$ref": "#/components/schemas/User"}
+						},
+						"application/xml": {
+							"schema": {"$ref": "#/components/schemas/User"}
+						}
+					}
+				}
+			}
+		},
+		"/users/{id}": {
+			"parameters": [
+				{"$ref": "#/components/parameters/UserID"}
+			],
+			"get": {
+				"operationId": "getUser"
+			},
+			"put": {
+				"operationId": "updateUser",
+				"requestBody": {
+					"$ref": "#/components/requestBodies/User"
+				}
+			}
+		},
+		"/files/{name}.json": {
+			"get": {
+				"operationId": "getFile"
+			}
+		},
+		"/nodes": {
+			"post": {
+				"operationId": "createNode",
+				"requestBody": {
+					"required": true,
+					"content": {
+						"application/json": {
+							"schema": {"$ref": "#/components/schemas/Node"}
+						}
+					}
+				}
+			}
+		}
+	},
+	"components": {
+		"parameters": {
+			"UserID": {
+				"name": "id",
+				"in": "path",
+				"required": true,
+				"schema": {"type": "string", "pattern": "^[0-9]+$"}
+			}
+		},
+		"requestBodies": {
+			"User": {
+				"required": true,
+				"content": {
+					"application/json": {
+						"schema": {"$ref": "#/components/schemas/User"}
+					}
+				}
+			}
+		},
+		"schemas": {
+			"User": {
+				"type": "object",
+				"required": ["name", "address"],
+				"properties": {
+					"name": {"type": "string"},
+					"birthday": {"type": "string", "format": "date"},
+					"created": {"type": "string", "format": "date-time"},
+					"married": {"type": "boolean"},
+					"tags": {"type": "array", "items": {"type": "string"}},
+					"address": {"$ref": "#/components/schemas/Address"},
+					"other_addresses": {"type": "array", "items": {"$ref": "#/components/schemas/Address"}},
+					"nickname": {"type": ["string", "null"]},
+					"pet": {"oneOf": [{"type": "string"}, {"type": "number"}]}
+				}
+			},
+			"Address": {
+				"type": "object",
+				"required": ["zip"],
+				"properties": {
+					"zip": {"type": "string", "pattern": "^[0-9]{5}$"}
+				}
+			},
+			"Node": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string"},
+					"children": {"type": "array", "items": {"$ref": "#/components/schemas/Node"}}
+				}
+			}
+		}
+	}
+}`
+
+func TestImportJSON(t *testing.T) {
+	operations, unsupported, err := ImportJSON(strings.NewReader(testOpenAPIJSON))
+	if err != nil {
+		t.Fatalf("ImportJSON() error = %v", err)
+	}
+
+	ids := []string{}
+	for _, op := range operations {
+		ids = append(ids, op.OperationID)
+	}
+	if got, want := strings.Join(ids, ","), "createNode,listUsers,createUser,getUser,updateUser"; got != want {
+		t.Errorf("ImportJSON() operations = %s, want %s", got, want)
+	}
+
+	wantUnsupported := []string{
+		"GET /files/{name}.json|operation skipped: path template segment [{name}.json] not supported",
+		"POST /nodes body.children[]|recursive schema reference [#/components/schemas/Node] not supported",
+		"POST /users body|request body media type [application/xml] not supported",
+		"POST /users body.nickname|null values not supported",
+		"POST /users body.pet|oneOf not supported",
+	}
+	reported := map[string]bool{}
+	for _, u := range unsupported {
+		reported[u.Location+"|"+u.Msg] = true
+	}
+	for _, want := range wantUnsupported {
+		if !reported[want] {
+			t.Errorf("ImportJSON() unsupported [%s] not reported, got %v", want, unsupported)
+		}
+	}
+	for _, u := range unsupported {
+		if u.Location == "GET /users header [Accept]" {
+			t.Errorf("ImportJSON() accept header should be ignored")
+		}
+	}
+}
+
+func TestImportJSON_Validate(t *testing.T) {
+	operations, _, err := ImportJSON(strings.NewReader(testOpenAPIJSON))
+	if err != nil {
+		t.Fatalf("ImportJSON() error = %v", err)
+	}
+	imported := map[string]ImportedOperation{}
+	for _, op := range operations {
+		imported[op.OperationID] = op
+	}
+	type args struct {
+		operationID string
+		req         *http.Request
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success: query and header",
+			args: args{
+				operationID: "listUsers",
+				req: func() *http.Request {
+					r := httptest.NewRequest(http.MethodGet, "http://www.test.com/users?limit=10&status=active&ids=1,2", nil)
+					r.Header.Set("X-Request-ID", "abc-123")
+					return r
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: query",
+			args: args{
+				operationID: "listUsers",
+				req: func() *http.Request {
+					r := httptest.NewRequest(http.MethodGet, "http://www.test.com/users?limit=1000&status=deleted", nil)
+					r.Header.Set("X-Request-ID", "abc-123")
+					return r
+				}(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "success: path variable",
+			args: args{
+				operationID: "getUser",
+				req:         httptest.NewRequest(http.MethodGet, "http://www.test.com/users/10", nil),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: path variable",
+			args: args{
+				operationID: "getUser",
+				req:         httptest.NewRequest(http.MethodGet, "http://www.test.com/users/gary", nil),
+			},
+			wantErr: true,
+		},
+		{
+			name: "success: body",
+			args: args{
+				operationID: "updateUser",
+				req: httptest.NewRequest(http.MethodPut, "http://www.test.com/users/10", strings.NewReader(`{
+					"name": "Gary",
+					"birthday": "1999-05-15",
+					"created": "2020-01-02T15:04:05Z",
+					"married": false,
+					"tags": ["one"],
+					"address": {"zip": "12345"},
+					"other_addresses": [{"zip": "54321"}]
+				}`)),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: body",
+			args: args{
+				operationID: "updateUser",
+				req: httptest.NewRequest(http.MethodPut, "http://www.test.com/users/10", strings.NewReader(`{
+					"name": "Gary",
+					"address": {"zip": "1234"}
+				}`)),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, has := imported[tt.args.operationID]
+			if !has {
+				t.Fatalf("operation [%s] not imported", tt.args.operationID)
+			}
+			if err := op.Schema.Validate(tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestImportJSON_Version(t *testing.T) {
+	_, _, err := ImportJSON(strings.NewReader(`{"swagger": "2.0", "paths": {}}`))
+	if err == nil {
+		t.Errorf("ImportJSON() expected a version error")
+	}
+}
@@ -0,0 +1,156 @@
+package query
+
+import (
+	"encoding"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Bind validates the request query with the schema and then populates the T
+// struct fields that have a query tag.  Inline array parameters are split with
+// the schema seperator.  A schema error is returned when the validation fails
+// and a bind error when a parameter can not be set on T.
+func Bind[T any](schema Schema, req *http.Request) (T, error) {
+	var value T
+	if err := schema.Validate(req); err != nil {
+		return value, err
+	}
+	values, err := url.ParseQuery(req.URL.RawQuery)
+	if err != nil {
+		return value, &rerror.BindErr{
+			Msg: "request query bind",
+			Err: err.Error(),
+		}
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	if v.Kind() != reflect.Struct {
+		return value, &rerror.BindErr{
+			Msg: fmt.Sprintf("request query bind: value must be a struct [%T]", value),
+		}
+	}
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		name, ok := sf.Tag.Lookup("query")
+		if !ok || name == "-" || !sf.IsExported() {
+			continue
+		}
+		properties := schema.Parameters[name]
+		params := parameterValues(properties, values[name])
+		if len(params) == 0 {
+			continue
+		}
+		if err := bindValues(v.Field(i), params, properties.Validation.timeFormat()); err != nil {
+			return value, &rerror.BindErr{
+				Msg:   "request query bind",
+				Field: name,
+				Err:   err.Error(),
+			}
+		}
+	}
+	return value, nil
+}
+
+func parameterValues(properties ParameterProperties, values []string) []string {
+	switch {
+	case len(values) == 0 || len(values[0]) == 0:
+		return nil
+	case properties.InlineArray:
+		return strings.Split(values[0], properties.InlineArraySeperator)
+	default:
+		// the schema validates the first value of a repeated parameter, so
+		// it is the only one bound.
+		return values[:1]
+	}
+}
+
+func (p ParameterValidation) timeFormat() string {
+	switch {
+	case p.Time != nil && len(p.Time.Format) > 0:
+		return p.Time.Format
+	case p.TimeArray != nil && len(p.TimeArray.Format) > 0:
+		return p.TimeArray.Format
+	default:
+		return time.RFC3339
+	}
+}
+
+func bindValues(field reflect.Value, values []string, format string) error {
+	if field.Kind() == reflect.Slice {
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := bindValue(slice.Index(i), value, format); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+	if len(values) > 1 {
+		return fmt.Errorf("multiple values can not be set to [%s]", field.Type())
+	}
+	return bindValue(field, values[0], format)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func bindValue(field reflect.Value, value string, format string) error {
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := bindValue(ptr.Elem(), value, format); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+	if field.Type() == timeType {
+		t, err := time.Parse(format, value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("type [%s] is not supported", field.Type())
+	}
+	return nil
+}
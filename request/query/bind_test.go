@@ -0,0 +1,121 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testBindSchemaJSON = `{
+	"title": "list users",
+	"parameters": {
+		"name": {"validation": {"string_validator": {}}},
+		"status": {"validation": {"string_validator": {"one_of": ["active", "inactive"]}}},
+		"limit": {"validation": {"number_validator": {"min": 1}}},
+		"active": {"validation": {"boolean_validator": {}}},
+		"since": {"validation": {"time_validator": {"format": "2006-01-02"}}},
+		"ids": {
+			"inline_array": true,
+			"inline_array_seperator": ",",
+			"validation": {"number_array_validator": {}}
+		}
+	}
+}`
+
+type testBindQuery struct {
+	Name   string    `query:"name"`
+	Limit  *int      `query:"limit"`
+	Active bool      `query:"active"`
+	Since  time.Time `query:"since"`
+	IDs    []int64   `query:"ids"`
+	Status []string  `query:"status"`
+	Other  string
+}
+
+func TestBind(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testBindSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	type args struct {
+		query string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    testBindQuery
+		wantErr error
+	}{
+		{
+			name: "success",
+			args: args{
+				query: "name=gary&limit=10&active=true&since=2020-01-02&ids=1,2,3",
+			},
+			want: testBindQuery{
+				Name: "gary",
+				Limit: func() *int {
+					i := 10
+					return &i
+				}(),
+				Active: true,
+				Since:  time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				IDs:    []int64{1, 2, 3},
+			},
+		},
+		{
+			name: "success: not present",
+			args: args{
+				query: "name=gary",
+			},
+			want: testBindQuery{
+				Name: "gary",
+			},
+		},
+		{
+			name: "success: repeated",
+			args: args{
+				query: "name=gary&limit=10&limit=abc&status=active&status=unknown",
+			},
+			want: testBindQuery{
+				Name: "gary",
+				Limit: func() *int {
+					i := 10
+					return &i
+				}(),
+				Status: []string{"active"},
+			},
+		},
+		{
+			name: "fail: validation",
+			args: args{
+				query: "limit=0",
+			},
+			wantErr: &rerror.SchemaErr{},
+		},
+		{
+			name: "fail: bind",
+			args: args{
+				query: "limit=1.5",
+			},
+			wantErr: &rerror.BindErr{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://www.test.com/users?"+tt.args.query, nil)
+			got, err := Bind[testBindQuery](schema, req)
+			if (err != nil || tt.wantErr != nil) && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Bind() error = %v, wantErr %T", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bind() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
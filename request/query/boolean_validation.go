@@ -1,20 +1,17 @@
 package query
 
-import (
-	"fmt"
-	"strconv"
-
-	"github.com/g8rswimmer/httpx/request/internal/parameter"
-)
+import "github.com/g8rswimmer/httpx/request/internal/parameter"
 
 type BooleanValidator struct {
 	parameter.BooleanValidator
 }
 
 func (p BooleanValidator) Validate(value string) error {
-	b, err := strconv.ParseBool(value)
-	if err != nil {
-		return fmt.Errorf("query value is not a boolean [%s] %w", value, err)
-	}
-	return p.BooleanValidator.Validate(b)
+	return p.BooleanValidator.ValidateString("query", value)
+}
+
+// Compile returns a copy of the validator.
+func (p BooleanValidator) Compile() (BooleanValidator, error) {
+	v, err := p.BooleanValidator.Compile()
+	return BooleanValidator{BooleanValidator: v}, err
 }
@@ -0,0 +1,60 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	compiled := s
+	compiled.RequiredFields = s.RequiredFields.Clone()
+	parameters, issues := parameter.CompileParameters(s.Parameters)
+	compiled.Parameters = parameters
+	if err := rerror.LintFromIssues("query schema compile", issues); err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{schema: compiled}, nil
+}
+
+// Validate validates the request query parameters.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.Validate(req)
+}
+
+func (p ParameterValidation) Compile() (ParameterValidation, error) {
+	var err error
+	if p.String, err = parameter.Compile(p.String); err != nil {
+		return ParameterValidation{}, err
+	}
+	if p.Number, err = parameter.Compile(p.Number); err != nil {
+		return ParameterValidation{}, err
+	}
+	if p.Time, err = parameter.Compile(p.Time); err != nil {
+		return ParameterValidation{}, err
+	}
+	if p.Boolean, err = parameter.Compile(p.Boolean); err != nil {
+		return ParameterValidation{}, err
+	}
+	if p.StringArray, err = parameter.Compile(p.StringArray); err != nil {
+		return ParameterValidation{}, err
+	}
+	if p.TimeArray, err = parameter.Compile(p.TimeArray); err != nil {
+		return ParameterValidation{}, err
+	}
+	if p.NumberArray, err = parameter.Compile(p.NumberArray); err != nil {
+		return ParameterValidation{}, err
+	}
+	return p, nil
+}
+
+func (p ParameterProperties) Compile() (ParameterProperties, error) {
+	validation, err := p.Validation.Compile()
+	p.Validation = validation
+	return p, err
+}
@@ -0,0 +1,173 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testCompileSchemaJSON = `{
+	"required_fields": {"one_of": [["name"]]},
+	"parameters": {
+		"name": {"validation": {"string_validator": {"regex": "^[a-z]+-[0-9]+$"}}},
+		"status": {"validation": {"string_validator": {"one_of": ["active", "inactive", "pending"]}}},
+		"since": {"validation": {"time_validator": {"format": "2006-01-02", "after": "2000-01-01", "before": "2030-01-01"}}},
+		"tags": {"inline_array": true, "inline_array_seperator": ",", "validation": {"string_array_validator": {"regex": "^[a-z]+$"}}}
+	}
+}`
+
+func TestSchema_Compile(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: testCompileSchemaJSON,
+			},
+			want: nil,
+		},
+		{
+			name: "fail: compile errors",
+			args: args{
+				schema: `{
+					"parameters": {
+						"name": {"validation": {"string_validator": {"regex": "[a-z"}}},
+						"since": {"validation": {"time_validator": {"format": "2006-01-02", "before": "01/01/2030"}}},
+						"tags": {"inline_array": true, "inline_array_seperator": ",", "validation": {"string_array_validator": {"regex": "(a"}}}
+					}
+				}`,
+			},
+			want: []string{
+				"/parameters/name/validation",
+				"/parameters/since/validation",
+				"/parameters/tags/validation",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema Schema
+			if err := json.NewDecoder(strings.NewReader(tt.args.schema)).Decode(&schema); err != nil {
+				t.Fatalf("schema decode error = %v", err)
+			}
+			compiled, err := schema.Compile()
+			if tt.want == nil {
+				if err != nil || compiled == nil {
+					t.Errorf("Schema.Compile() error = %v", err)
+				}
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("Schema.Compile() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Schema.Compile() issues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompiledSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testCompileSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			query:   "name=abc-123&status=active&since=2020-01-01&tags=a,b",
+			wantErr: false,
+		},
+		{
+			name:    "fail: reg exp",
+			query:   "name=abc",
+			wantErr: true,
+		},
+		{
+			name:    "fail: one of",
+			query:   "name=abc-123&status=closed",
+			wantErr: true,
+		},
+		{
+			name:    "fail: before",
+			query:   "name=abc-123&since=2040-01-01",
+			wantErr: true,
+		},
+		{
+			name:    "fail: array reg exp",
+			query:   "name=abc-123&tags=a,B",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://www.test.com/?"+tt.query, nil)
+			err := compiled.Validate(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompiledSchema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if want := schema.Validate(req); !reflect.DeepEqual(err, want) {
+				t.Errorf("CompiledSchema.Validate() error = %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+func BenchmarkSchema_Validate(b *testing.B) {
+	schema, err := SchemaFromJSON(strings.NewReader(testCompileSchemaJSON))
+	if err != nil {
+		b.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "http://www.test.com/?name=abc-123&status=pending&since=2020-01-01&tags=a,b,c", nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := schema.Validate(req); err != nil {
+			b.Fatalf("Schema.Validate() error = %v", err)
+		}
+	}
+}
+
+func BenchmarkCompiledSchema_Validate(b *testing.B) {
+	schema, err := SchemaFromJSON(strings.NewReader(testCompileSchemaJSON))
+	if err != nil {
+		b.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		b.Fatalf("Schema.Compile() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "http://www.test.com/?name=abc-123&status=pending&since=2020-01-01&tags=a,b,c", nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := compiled.Validate(req); err != nil {
+			b.Fatalf("CompiledSchema.Validate() error = %v", err)
+		}
+	}
+}
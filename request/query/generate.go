@@ -0,0 +1,114 @@
+package query
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/internal/tag"
+)
+
+// SchemaFromType generates a schema from the T struct fields that have a query
+// tag.  The validation options are from the httpx tags, slices are inline
+// arrays that are seperated by a comma unless the seperator option is present.
+func SchemaFromType[T any]() (Schema, error) {
+	t := tag.Indirect(reflect.TypeOf((*T)(nil)).Elem())
+	if t.Kind() != reflect.Struct {
+		return Schema{}, fmt.Errorf("schema from type [%s]: type must be a struct", t)
+	}
+	schema := Schema{
+		Parameters: map[string]ParameterProperties{},
+	}
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, ok := sf.Tag.Lookup("query")
+		if !ok || name == "-" || !sf.IsExported() {
+			continue
+		}
+		options, err := tag.Parse(sf.Tag.Get(tag.Name))
+		if err != nil {
+			return Schema{}, fmt.Errorf("schema from type [%s] field [%s]: %w", t, sf.Name, err)
+		}
+		properties, err := parameterProperties(sf.Type, options)
+		if err != nil {
+			return Schema{}, fmt.Errorf("schema from type [%s] field [%s]: %w", t, sf.Name, err)
+		}
+		if _, has := schema.Parameters[name]; has {
+			return Schema{}, fmt.Errorf("schema from type [%s] field [%s]: parameter [%s] already present", t, sf.Name, name)
+		}
+		schema.Parameters[name] = properties
+		if options.Required {
+			required = append(required, name)
+		}
+	}
+	if len(required) > 0 {
+		schema.RequiredFields.OneOf = [][]string{required}
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema from type [%s]: %w", t, err)
+	}
+	return schema, nil
+}
+
+func parameterProperties(t reflect.Type, options tag.Options) (ParameterProperties, error) {
+	kind := tag.KindOf(t)
+	if err := options.Allowed(kind); err != nil {
+		return ParameterProperties{}, err
+	}
+	properties := ParameterProperties{}
+	switch kind {
+	case tag.String:
+		properties.Validation.String = &parameter.StringValidator{
+			RegEx: options.RegEx,
+			OneOf: options.OneOf,
+		}
+	case tag.Number:
+		oneOf, err := options.Float64s()
+		if err != nil {
+			return ParameterProperties{}, err
+		}
+		properties.Validation.Number = &NumberValidator{
+			NumberValidator: parameter.NumberValidator{
+				Min:   options.Min,
+				Max:   options.Max,
+				OneOf: oneOf,
+			},
+		}
+	case tag.Boolean:
+		properties.Validation.Boolean = &BooleanValidator{}
+	case tag.Time:
+		properties.Validation.Time = &parameter.TimeValidator{
+			Format: options.Format(),
+			Before: options.Before,
+			After:  options.After,
+		}
+	case tag.StringArray:
+		properties.Validation.StringArray = &parameter.StringArrayValidator{
+			RegEx: options.RegEx,
+		}
+	case tag.NumberArray:
+		properties.Validation.NumberArray = &NumberArrayValidator{
+			NumberArrayValidator: parameter.NumberArrayValidator{
+				Min: options.Min,
+				Max: options.Max,
+			},
+		}
+	case tag.TimeArray:
+		properties.Validation.TimeArray = &parameter.TimeArrayValidator{
+			Format: options.Format(),
+			Before: options.Before,
+			After:  options.After,
+		}
+	default:
+		return ParameterProperties{}, fmt.Errorf("type [%s] is not supported", t)
+	}
+	if properties.Validation.StringArray != nil || properties.Validation.NumberArray != nil || properties.Validation.TimeArray != nil {
+		properties.InlineArray = true
+		properties.InlineArraySeperator = ","
+		if len(options.Seperator) > 0 {
+			properties.InlineArraySeperator = options.Seperator
+		}
+	}
+	return properties, nil
+}
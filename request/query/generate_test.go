@@ -0,0 +1,78 @@
+package query
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type testGenerateQuery struct {
+	Limit  int             `query:"limit" httpx:"required,min=1,max=100"`
+	Status string          `query:"status" httpx:"one_of=active|inactive"`
+	Since  time.Time       `query:"since" httpx:"time_format=2006-01-02"`
+	IDs    []float64       `query:"ids"`
+	Tags   []string        `query:"tags" httpx:"seperator=|,regex=^[a-z]+$"`
+	Active *bool           `query:"active"`
+	Other  string          `json:"other"`
+	Nested struct{ A int } `query:"-"`
+}
+
+func TestSchemaFromType(t *testing.T) {
+	schema, err := SchemaFromType[testGenerateQuery]()
+	if err != nil {
+		t.Fatalf("SchemaFromType() error = %v", err)
+	}
+	if len(schema.Parameters) != 6 {
+		t.Errorf("SchemaFromType() parameters = %d, want 6", len(schema.Parameters))
+	}
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			query:   "limit=10&status=active&since=2020-01-02&ids=1,2&tags=a|b&active=true",
+			wantErr: false,
+		},
+		{
+			name:    "fail: required",
+			query:   "status=active",
+			wantErr: true,
+		},
+		{
+			name:    "fail: seperator",
+			query:   "limit=10&tags=a,b",
+			wantErr: true,
+		},
+		{
+			name:    "fail: max",
+			query:   "limit=1000",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://www.test.com/users?"+tt.query, nil)
+			if err := schema.Validate(req); (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaFromType_Failure(t *testing.T) {
+	type object struct {
+		Nested struct{ A int } `query:"nested"`
+	}
+	if _, err := SchemaFromType[object](); err == nil {
+		t.Errorf("SchemaFromType() expected a type error")
+	}
+	type options struct {
+		Active bool `query:"active" httpx:"regex=^true$"`
+	}
+	if _, err := SchemaFromType[options](); err == nil {
+		t.Errorf("SchemaFromType() expected a tag option error")
+	}
+}
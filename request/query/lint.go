@@ -0,0 +1,65 @@
+package query
+
+import (
+	"sort"
+
+	"github.com/g8rswimmer/httpx/request/internal/field"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Lint returns an error with all of the schema rules that contradict each
+// other, or can never be satisfied.
+func Lint(schema Schema) error {
+	issues := schema.RequiredFields.Lint("/required_fields", field.Set(schema.Parameters))
+
+	params := make([]string, 0, len(schema.Parameters))
+	for param := range schema.Parameters {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+	for _, param := range params {
+		properties := schema.Parameters[param]
+		path := "/parameters/" + rerror.PointerToken(param)
+		v := properties.Validation
+		array := v.StringArray != nil || v.NumberArray != nil || v.TimeArray != nil
+		switch {
+		case properties.InlineArray && (v.String != nil || v.Number != nil || v.Time != nil || v.Boolean != nil):
+			issues = append(issues, rerror.LintIssue{
+				Path: path + "/inline_array",
+				Msg:  "inline array requires an array validator",
+			})
+		case !properties.InlineArray && array:
+			issues = append(issues, rerror.LintIssue{
+				Path: path + "/validation",
+				Msg:  "array validator requires an inline array",
+			})
+		}
+		issues = append(issues, v.Lint(path+"/validation")...)
+	}
+	return rerror.LintFromIssues("query schema lint", issues)
+}
+
+// Lint returns the rules of the validators that contradict each other, or can
+// never be satisfied, with path as the JSON Pointer of the validation.
+func (p ParameterValidation) Lint(path string) []rerror.LintIssue {
+	var issues []rerror.LintIssue
+	if p.String != nil {
+		issues = append(issues, p.String.Lint(path+"/string_validator")...)
+	}
+	if p.Number != nil {
+		issues = append(issues, p.Number.Lint(path+"/number_validator")...)
+	}
+	if p.Time != nil {
+		issues = append(issues, p.Time.Lint(path+"/time_validator")...)
+	}
+	if p.StringArray != nil {
+		issues = append(issues, p.StringArray.Lint(path+"/string_array_validator")...)
+	}
+	if p.NumberArray != nil {
+		issues = append(issues, p.NumberArray.Lint(path+"/number_array_validator")...)
+	}
+	if p.TimeArray != nil {
+		issues = append(issues, p.TimeArray.Lint(path+"/time_array_validator")...)
+	}
+	return issues
+}
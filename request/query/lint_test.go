@@ -0,0 +1,86 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func TestLint(t *testing.T) {
+	type args struct {
+		schema string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: `{
+					"required_fields": {"one_of": [["limit"]]},
+					"parameters": {
+						"limit": {"validation": {"number_validator": {"min": 1, "max": 10}}},
+						"ids": {"inline_array": true, "inline_array_seperator": ",", "validation": {"number_array_validator": {}}}
+					}
+				}`,
+			},
+			want: nil,
+		},
+		{
+			name: "fail: contradictions",
+			args: args{
+				schema: `{
+					"required_fields": {"one_of": [["limit", "offset"]], "present": {"sort": ["order"]}},
+					"parameters": {
+						"limit": {"validation": {"number_validator": {"min": 10, "max": 1}}},
+						"ids": {"validation": {"number_array_validator": {}}},
+						"status": {"validation": {"string_validator": {"regex": "^[a-z]+$", "one_of": ["active", "IN"]}}},
+						"since": {"inline_array": true, "inline_array_seperator": ",", "validation": {"time_validator": {"format": "2006-01-02", "value": "01/02/2006"}}}
+					}
+				}`,
+			},
+			want: []string{
+				"/required_fields/one_of/0/1",
+				"/required_fields/present/sort",
+				"/required_fields/present/sort/0",
+				"/parameters/ids/validation",
+				"/parameters/limit/validation/number_validator/min",
+				"/parameters/since/inline_array",
+				"/parameters/since/validation/time_validator/value",
+				"/parameters/status/validation/string_validator/one_of/1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schema Schema
+			if err := json.NewDecoder(strings.NewReader(tt.args.schema)).Decode(&schema); err != nil {
+				t.Fatalf("schema decode error = %v", err)
+			}
+			err := Lint(schema)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Lint() error = %v", err)
+				}
+				return
+			}
+			var lintErr *rerror.LintErr
+			if !errors.As(err, &lintErr) {
+				t.Fatalf("Lint() error = %v, want a lint error", err)
+			}
+			got := []string{}
+			for _, issue := range lintErr.Issues {
+				got = append(got, issue.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lint() paths = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
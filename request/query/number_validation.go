@@ -1,22 +1,13 @@
 package query
 
-import (
-	"fmt"
-	"strconv"
-
-	"github.com/g8rswimmer/httpx/request/internal/parameter"
-)
+import "github.com/g8rswimmer/httpx/request/internal/parameter"
 
 type NumberValidator struct {
 	parameter.NumberValidator
 }
 
 func (p NumberValidator) Validate(value string) error {
-	num, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return fmt.Errorf("query value is not a number [%s] %w", value, err)
-	}
-	return p.NumberValidator.Validate(num)
+	return p.NumberValidator.ValidateString("query", value)
 }
 
 type NumberArrayValidator struct {
@@ -24,13 +15,17 @@ type NumberArrayValidator struct {
 }
 
 func (n NumberArrayValidator) Validate(values []string) error {
-	nums := make([]float64, len(values))
-	for i, value := range values {
-		num, err := strconv.ParseFloat(value, 64)
-		if err != nil {
-			return fmt.Errorf("query value is not a number [%s] %w", value, err)
-		}
-		nums[i] = num
-	}
-	return n.NumberArrayValidator.Validate(nums)
+	return n.NumberArrayValidator.ValidateStrings("query", values)
+}
+
+// Compile returns a copy of the validator with the one of values in a set.
+func (p NumberValidator) Compile() (NumberValidator, error) {
+	v, err := p.NumberValidator.Compile()
+	return NumberValidator{NumberValidator: v}, err
+}
+
+// Compile returns a copy of the validator.
+func (n NumberArrayValidator) Compile() (NumberArrayValidator, error) {
+	v, err := n.NumberArrayValidator.Compile()
+	return NumberArrayValidator{NumberArrayValidator: v}, err
 }
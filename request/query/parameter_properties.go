@@ -193,6 +193,32 @@ func (p ParameterProperties) Validate(value string) error {
 	return nil
 }
 
+// ValidateValues validates the values of a parameter that can be repeated, an
+// array validator validates all of the values and the other validators
+// require a single value.  Inline array values are split with the seperator.
+func (p ParameterProperties) ValidateValues(values []string) error {
+	v := p.Validation
+	switch {
+	case len(values) == 0:
+		return nil
+	case v.StringArray != nil || v.NumberArray != nil || v.TimeArray != nil:
+		if p.InlineArray {
+			split := []string{}
+			for _, value := range values {
+				split = append(split, strings.Split(value, p.InlineArraySeperator)...)
+			}
+			values = split
+		}
+		return v.ValidateValues(values)
+	case len(values) > 1:
+		return fmt.Errorf("multiple values present [%d]", len(values))
+	case len(values[0]) == 0:
+		return nil
+	default:
+		return v.ValidateValue(values[0])
+	}
+}
+
 func SchemaModelParameterPropertiesValidator(properties ParameterProperties) error {
 	if err := properties.Validation.validator(); err != nil {
 		return fmt.Errorf("propoerties data type error: %w", err)
@@ -1,13 +1,14 @@
 package query
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"net/url"
 
+	"github.com/g8rswimmer/httpx/internal/schemaref"
 	"github.com/g8rswimmer/httpx/request/internal/field"
 	"github.com/g8rswimmer/httpx/request/rerror"
 )
@@ -49,7 +50,7 @@ func (s Schema) Validate(req *http.Request) error {
 
 func SchemaFromJSON(reader io.Reader) (Schema, error) {
 	var schema Schema
-	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+	if err := schemaref.Decode(reader, &schema); err != nil {
 		return Schema{}, fmt.Errorf("schema decode json: %w", err)
 	}
 	if err := SchemaModelValidator(schema); err != nil {
@@ -58,6 +59,34 @@ func SchemaFromJSON(reader io.Reader) (Schema, error) {
 	return schema, nil
 }
 
+// SchemaFromYAML decodes the schema from YAML, with the same field names as
+// the JSON schema, and the errors have the line and column of the value.
+func SchemaFromYAML(reader io.Reader) (Schema, error) {
+	var schema Schema
+	doc, err := schemaref.DecodeYAML(reader, &schema)
+	if err != nil {
+		return Schema{}, fmt.Errorf("schema decode yaml: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", doc.Locate(err))
+	}
+	return schema, nil
+}
+
+// SchemaFromFS decodes the schema file of the file system, the references to
+// other files are relative to the file.  A file with a .yaml or .yml
+// extension is YAML and any other file is JSON.
+func SchemaFromFS(fsys fs.FS, name string) (Schema, error) {
+	var schema Schema
+	if err := schemaref.DecodeFS(fsys, name, &schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode file: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
 func SchemaModelValidator(schema Schema) error {
 	switch {
 	case len(schema.Parameters) == 0:
@@ -74,5 +103,8 @@ func SchemaModelValidator(schema Schema) error {
 	if err := schema.RequiredFields.Validate(parameters); err != nil {
 		return fmt.Errorf("scheam required paramters missing: %w", err)
 	}
+	if err := Lint(schema); err != nil {
+		return err
+	}
 	return nil
 }
@@ -3,7 +3,10 @@ package query
 import (
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/g8rswimmer/httpx/request/internal/field"
 	"github.com/g8rswimmer/httpx/request/internal/parameter"
@@ -372,3 +375,190 @@ func TestSchema_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaFromYAML(t *testing.T) {
+	type args struct {
+		yaml string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr string
+	}{
+		{
+			name: "success",
+			args: args{
+				yaml: `
+title: Schema Example
+required_fields:
+  one_of:
+    - [first_name, age]
+parameters:
+  first_name:
+    example: Gary
+    validation:
+      string_validator:
+        regex: "^[A-Z][a-z]+$"
+  age:
+    example: "29"
+    validation:
+      number_validator:
+        min: 18
+        max: 100
+  children:
+    inline_array: true
+    inline_array_seperator: "|"
+    validation:
+      string_array_validator: {}
+`,
+			},
+			want: `{
+				"title": "Schema Example",
+				"required_fields": {"one_of": [["first_name", "age"]]},
+				"parameters": {
+					"first_name": {"example": "Gary", "validation": {"string_validator": {"regex": "^[A-Z][a-z]+$"}}},
+					"age": {"example": "29", "validation": {"number_validator": {"min": 18, "max": 100}}},
+					"children": {"inline_array": true, "inline_array_seperator": "|", "validation": {"string_array_validator": {}}}
+				}
+			}`,
+		},
+		{
+			name: "fail: decode",
+			args: args{
+				yaml: `
+parameters:
+  age:
+    validation:
+      number_validator:
+        min: eighteen
+`,
+			},
+			wantErr: "line 6, column 14",
+		},
+		{
+			name: "fail: lint",
+			args: args{
+				yaml: `
+parameters:
+  age:
+    validation:
+      number_validator:
+        min: 100
+        max: 18
+`,
+			},
+			wantErr: "line 6, column 9",
+		},
+		{
+			name: "fail: model",
+			args: args{
+				yaml: `
+title: Schema Example
+`,
+			},
+			wantErr: "schema parameters title is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SchemaFromYAML(strings.NewReader(tt.args.yaml))
+			if len(tt.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("SchemaFromYAML() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SchemaFromYAML() error = %v", err)
+			}
+			want, err := SchemaFromJSON(strings.NewReader(tt.want))
+			if err != nil {
+				t.Fatalf("SchemaFromJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("SchemaFromYAML() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSchemaFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"users.json": {Data: []byte(`{
+			"parameters": {
+				"limit": {"$ref": "common/pagination.yaml#/definitions/limit"},
+				"offset": {"$ref": "common/pagination.yaml#/definitions/offset"}
+			}
+		}`)},
+		"common/pagination.yaml": {Data: []byte(`
+definitions:
+  limit:
+    validation:
+      number_validator:
+        min: 1
+        max: 100
+  offset:
+    validation:
+      number_validator:
+        min: 0
+`)},
+		"cycle.json": {Data: []byte(`{
+			"definitions": {
+				"limit": {"$ref": "#/definitions/offset"},
+				"offset": {"$ref": "#/definitions/limit"}
+			},
+			"parameters": {
+				"limit": {"$ref": "#/definitions/limit"}
+			}
+		}`)},
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				name: "users.json",
+			},
+			want: `{
+				"parameters": {
+					"limit": {"validation": {"number_validator": {"min": 1, "max": 100}}},
+					"offset": {"validation": {"number_validator": {"min": 0}}}
+				}
+			}`,
+			wantErr: false,
+		},
+		{
+			name: "fail: cycle",
+			args: args{
+				name: "cycle.json",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SchemaFromFS(fsys, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SchemaFromFS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want, err := SchemaFromJSON(strings.NewReader(tt.want))
+			if err != nil {
+				t.Fatalf("SchemaFromJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("SchemaFromFS() = %v, want %v", got, want)
+			}
+		})
+	}
+}
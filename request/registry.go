@@ -0,0 +1,141 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type Registry struct {
+	root *route
+}
+
+type route struct {
+	static   map[string]*route
+	variable *route
+	schemas  map[string]Schema
+	compiled map[string]*CompiledSchema
+}
+
+func newRoute() *route {
+	return &route{
+		static:   map[string]*route{},
+		schemas:  map[string]Schema{},
+		compiled: map[string]*CompiledSchema{},
+	}
+}
+
+func (r *Registry) Add(schema Schema) error {
+	if schema.Endpoint == nil {
+		return errors.New("registry schema endpoint is required")
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return fmt.Errorf("registry schema validation: %w", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		return fmt.Errorf("registry schema compile: %w", err)
+	}
+	if r.root == nil {
+		r.root = newRoute()
+	}
+	node := r.root
+	for _, segment := range strings.Split(schema.Endpoint.Endpoint, "/") {
+		if _, has := schema.Endpoint.PathVariables[segment]; has {
+			if node.variable == nil {
+				node.variable = newRoute()
+			}
+			node = node.variable
+			continue
+		}
+		next, has := node.static[segment]
+		if !has {
+			next = newRoute()
+			node.static[segment] = next
+		}
+		node = next
+	}
+	if _, has := node.schemas[schema.Endpoint.Method]; has {
+		return fmt.Errorf("registry route [%s %s] already registered", schema.Endpoint.Method, schema.Endpoint.Endpoint)
+	}
+	node.schemas[schema.Endpoint.Method] = schema
+	node.compiled[schema.Endpoint.Method] = compiled
+	return nil
+}
+
+func (r *Registry) Lookup(req *http.Request) (Schema, error) {
+	node, err := r.lookup(req)
+	if err != nil {
+		return Schema{}, err
+	}
+	return node.schemas[req.Method], nil
+}
+
+// Validate validates the request with the compiled schema of the matching
+// route.
+func (r *Registry) Validate(req *http.Request) error {
+	node, err := r.lookup(req)
+	if err != nil {
+		return err
+	}
+	return node.compiled[req.Method].Validate(req)
+}
+
+// lookup returns the route with a schema for the request method.
+func (r *Registry) lookup(req *http.Request) (*route, error) {
+	var matches []*route
+	if r.root != nil {
+		matches = r.root.match(strings.Split(req.URL.Path, "/"), matches)
+	}
+	if len(matches) == 0 {
+		return nil, &rerror.RouteErr{
+			Msg:        "request route not found",
+			StatusCode: http.StatusNotFound,
+			Method:     req.Method,
+			Path:       req.URL.Path,
+		}
+	}
+	allowed := map[string]struct{}{}
+	for _, m := range matches {
+		if _, has := m.schemas[req.Method]; has {
+			return m, nil
+		}
+		for method := range m.schemas {
+			allowed[method] = struct{}{}
+		}
+	}
+	methods := make([]string, 0, len(allowed))
+	for method := range allowed {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return nil, &rerror.RouteErr{
+		Msg:        "request method not allowed",
+		StatusCode: http.StatusMethodNotAllowed,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Allowed:    methods,
+	}
+}
+
+// match collects the routes that terminate the segments, static segments are
+// preferred over path variables.
+func (r *route) match(segments []string, matches []*route) []*route {
+	if len(segments) == 0 {
+		if len(r.schemas) > 0 {
+			matches = append(matches, r)
+		}
+		return matches
+	}
+	if next, has := r.static[segments[0]]; has {
+		matches = next.match(segments[1:], matches)
+	}
+	if r.variable != nil {
+		matches = r.variable.match(segments[1:], matches)
+	}
+	return matches
+}
@@ -0,0 +1,274 @@
+package request
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/internal/parameter"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+func testRegistry(t *testing.T) *Registry {
+	t.Helper()
+	idVariable := map[string]endpoint.PathVariable{
+		"{id}": {
+			Validation: endpoint.VariableValidation{
+				Number: &endpoint.NumberValidator{},
+			},
+		},
+	}
+	schemas := []Schema{
+		{
+			Title: "list users",
+			Endpoint: &endpoint.Schema{
+				Method:   http.MethodGet,
+				Endpoint: "/users",
+			},
+		},
+		{
+			Title: "get user",
+			Endpoint: &endpoint.Schema{
+				Method:        http.MethodGet,
+				Endpoint:      "/users/{id}",
+				PathVariables: idVariable,
+			},
+		},
+		{
+			Title: "delete user",
+			Endpoint: &endpoint.Schema{
+				Method:        http.MethodDelete,
+				Endpoint:      "/users/{id}",
+				PathVariables: idVariable,
+			},
+		},
+		{
+			Title: "current user",
+			Endpoint: &endpoint.Schema{
+				Method:   http.MethodGet,
+				Endpoint: "/users/me",
+			},
+		},
+		{
+			Title: "user orders",
+			Endpoint: &endpoint.Schema{
+				Method:   http.MethodPost,
+				Endpoint: "/users/{user_id}/orders",
+				PathVariables: map[string]endpoint.PathVariable{
+					"{user_id}": {
+						Validation: endpoint.VariableValidation{
+							String: &endpoint.StringValidator{
+								StringValidator: parameter.StringValidator{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	r := &Registry{}
+	for _, schema := range schemas {
+		if err := r.Add(schema); err != nil {
+			t.Fatalf("Registry.Add() error = %v", err)
+		}
+	}
+	return r
+}
+
+func TestRegistry_Add(t *testing.T) {
+	type args struct {
+		schema Schema
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				schema: Schema{
+					Endpoint: &endpoint.Schema{
+						Method:   http.MethodPut,
+						Endpoint: "/users/me",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: duplicate",
+			args: args{
+				schema: Schema{
+					Endpoint: &endpoint.Schema{
+						Method:   http.MethodGet,
+						Endpoint: "/users/me",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: duplicate variable",
+			args: args{
+				schema: Schema{
+					Endpoint: &endpoint.Schema{
+						Method:   http.MethodGet,
+						Endpoint: "/users/{name}",
+						PathVariables: map[string]endpoint.PathVariable{
+							"{name}": {
+								Validation: endpoint.VariableValidation{
+									String: &endpoint.StringValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: no endpoint",
+			args: args{
+				schema: Schema{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testRegistry(t)
+			if err := r.Add(tt.args.schema); (err != nil) != tt.wantErr {
+				t.Errorf("Registry.Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	r := testRegistry(t)
+	type args struct {
+		req *http.Request
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantTitle      string
+		wantStatusCode int
+		wantAllowed    []string
+	}{
+		{
+			name: "success: static",
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/users", nil),
+			},
+			wantTitle: "list users",
+		},
+		{
+			name: "success: static over variable",
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/users/me", nil),
+			},
+			wantTitle: "current user",
+		},
+		{
+			name: "success: variable",
+			args: args{
+				req: httptest.NewRequest(http.MethodDelete, "http://www.test.com/users/me", nil),
+			},
+			wantTitle: "delete user",
+		},
+		{
+			name: "success: nested variable",
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/users/me/orders", nil),
+			},
+			wantTitle: "user orders",
+		},
+		{
+			name: "fail: not found",
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/orders", nil),
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "fail: method not allowed",
+			args: args{
+				req: httptest.NewRequest(http.MethodPatch, "http://www.test.com/users/10", nil),
+			},
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantAllowed:    []string{http.MethodDelete, http.MethodGet},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Lookup(tt.args.req)
+			if tt.wantStatusCode == 0 {
+				if err != nil {
+					t.Errorf("Registry.Lookup() error = %v", err)
+					return
+				}
+				if got.Title != tt.wantTitle {
+					t.Errorf("Registry.Lookup() = %s, want %s", got.Title, tt.wantTitle)
+				}
+				return
+			}
+			var routeErr *rerror.RouteErr
+			if !errors.As(err, &routeErr) {
+				t.Errorf("Registry.Lookup() error = %v, want *rerror.RouteErr", err)
+				return
+			}
+			if routeErr.StatusCode != tt.wantStatusCode {
+				t.Errorf("Registry.Lookup() status code = %d, want %d", routeErr.StatusCode, tt.wantStatusCode)
+			}
+			if !reflect.DeepEqual(routeErr.Allowed, tt.wantAllowed) {
+				t.Errorf("Registry.Lookup() allowed = %v, want %v", routeErr.Allowed, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	r := testRegistry(t)
+	type args struct {
+		req *http.Request
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/users/10", nil),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: path variable",
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/users/gary", nil),
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: not found",
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, "http://www.test.com/orders", nil),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.Validate(tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("Registry.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package rerror
+
+// BindErr is returned when a validated request can not be bound to a value.
+type BindErr struct {
+	Msg   string `json:"message"`
+	Field string `json:"field,omitempty"`
+	Err   string `json:"error,omitempty"`
+}
+
+func (b BindErr) Error() string {
+	return b.Msg
+}
+
+func (b *BindErr) Is(target error) bool {
+	_, ok := target.(*BindErr)
+	return ok
+}
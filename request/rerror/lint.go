@@ -0,0 +1,50 @@
+package rerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LintErr is returned when a schema has rules that contradict each other, or
+// can not be satisfied.
+type LintErr struct {
+	Msg    string      `json:"message"`
+	Issues []LintIssue `json:"issues"`
+}
+
+// LintIssue is a schema problem located by the JSON Pointer of the schema
+// rule, and the line and column of the rule when the schema is from a file
+// format that has them.
+type LintIssue struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+	Msg    string `json:"message"`
+}
+
+func (l LintErr) Error() string {
+	issues := make([]string, len(l.Issues))
+	for i, issue := range l.Issues {
+		if issue.Line > 0 {
+			issues[i] = fmt.Sprintf("%s (line %d, column %d): %s", issue.Path, issue.Line, issue.Column, issue.Msg)
+			continue
+		}
+		issues[i] = fmt.Sprintf("%s: %s", issue.Path, issue.Msg)
+	}
+	return fmt.Sprintf("%s: %s", l.Msg, strings.Join(issues, "; "))
+}
+
+func (l *LintErr) Is(target error) bool {
+	_, ok := target.(*LintErr)
+	return ok
+}
+
+func LintFromIssues(msg string, issues []LintIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	return &LintErr{
+		Msg:    msg,
+		Issues: issues,
+	}
+}
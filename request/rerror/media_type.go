@@ -0,0 +1,19 @@
+package rerror
+
+// MediaTypeErr is returned when the request Content-Type is not supported by
+// the schema, the status code is 415 Unsupported Media Type.
+type MediaTypeErr struct {
+	Msg         string   `json:"message"`
+	StatusCode  int      `json:"status_code"`
+	ContentType string   `json:"content_type"`
+	Supported   []string `json:"supported_media_types,omitempty"`
+}
+
+func (m MediaTypeErr) Error() string {
+	return m.Msg
+}
+
+func (m *MediaTypeErr) Is(target error) bool {
+	_, ok := target.(*MediaTypeErr)
+	return ok
+}
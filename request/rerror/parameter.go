@@ -1,15 +1,31 @@
 package rerror
 
+import "strings"
+
 type ParameterErr struct {
 	Parameters map[string]string `json:"parameters"`
+	Violations []Violation       `json:"violations,omitempty"`
+}
+
+// Violation is a parameter failure located by a RFC 6901 JSON Pointer.
+type Violation struct {
+	Pointer string `json:"pointer"`
+	Msg     string `json:"message"`
 }
 
 func (p *ParameterErr) Add(k string, msg string) {
 	p.Parameters[k] = msg
 }
 
+func (p *ParameterErr) AddViolation(pointer string, msg string) {
+	p.Violations = append(p.Violations, Violation{
+		Pointer: pointer,
+		Msg:     msg,
+	})
+}
+
 func (p ParameterErr) Has() bool {
-	return len(p.Parameters) > 0
+	return len(p.Parameters) > 0 || len(p.Violations) > 0
 }
 
 func (p ParameterErr) Error() string {
@@ -20,3 +36,10 @@ func (s *ParameterErr) Is(target error) bool {
 	_, ok := target.(*ParameterErr)
 	return ok
 }
+
+// PointerToken escapes a reference token of a JSON Pointer.
+func PointerToken(token string) string {
+	return pointerEscaper.Replace(token)
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
@@ -0,0 +1,18 @@
+package rerror
+
+type RouteErr struct {
+	Msg        string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+	Method     string   `json:"method"`
+	Path       string   `json:"path"`
+	Allowed    []string `json:"allowed_methods,omitempty"`
+}
+
+func (r RouteErr) Error() string {
+	return r.Msg
+}
+
+func (r *RouteErr) Is(target error) bool {
+	_, ok := target.(*RouteErr)
+	return ok
+}
@@ -7,6 +7,7 @@ type SchemaErr struct {
 	Field     *FieldErr     `json:"field_errors,omitempty"`
 	Parameter *ParameterErr `json:"parameter_errors,omitempty"`
 	Err       string        `json:"error,omitempty"`
+	Sections  []*SchemaErr  `json:"sections,omitempty"`
 }
 
 func (s SchemaErr) Error() string {
@@ -46,3 +47,27 @@ func SchemaFromError(msg string, err error) error {
 		}
 	}
 }
+
+func SchemaFromErrors(msg string, errs ...error) error {
+	sections := []*SchemaErr{}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var schemaErr *SchemaErr
+		if !errors.As(err, &schemaErr) {
+			schemaErr = &SchemaErr{
+				Msg: err.Error(),
+				Err: err.Error(),
+			}
+		}
+		sections = append(sections, schemaErr)
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+	return &SchemaErr{
+		Msg:      msg,
+		Sections: sections,
+	}
+}
@@ -0,0 +1,165 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/internal/schemaref"
+	"github.com/g8rswimmer/httpx/request/cookie"
+	"github.com/g8rswimmer/httpx/request/endpoint"
+	"github.com/g8rswimmer/httpx/request/form"
+	"github.com/g8rswimmer/httpx/request/header"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/mbody"
+	"github.com/g8rswimmer/httpx/request/query"
+	"github.com/g8rswimmer/httpx/request/rerror"
+	"github.com/g8rswimmer/httpx/request/xbody"
+)
+
+type Schema struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Endpoint    *endpoint.Schema `json:"endpoint"`
+	Query       *query.Schema    `json:"query"`
+	Header      *header.Schema   `json:"header"`
+	Cookie      *cookie.Schema   `json:"cookie"`
+	Body        *jbody.Schema    `json:"body"`
+	Form        *form.Schema     `json:"form"`
+	Multipart   *mbody.Schema    `json:"multipart"`
+	XML         *xbody.Schema    `json:"xml"`
+	Content     *Content         `json:"content"`
+}
+
+func (s Schema) Validate(req *http.Request) error {
+	errs := []error{}
+	if s.Endpoint != nil {
+		errs = append(errs, s.Endpoint.Validate(req))
+	}
+	if s.Query != nil {
+		errs = append(errs, s.Query.Validate(req))
+	}
+	if s.Header != nil {
+		errs = append(errs, s.Header.Validate(req))
+	}
+	if s.Cookie != nil {
+		errs = append(errs, s.Cookie.Validate(req))
+	}
+	if s.Body != nil {
+		errs = append(errs, s.Body.Validate(req))
+	}
+	if s.Form != nil {
+		errs = append(errs, s.Form.Validate(req))
+	}
+	if s.Multipart != nil {
+		errs = append(errs, s.Multipart.Validate(req))
+	}
+	if s.XML != nil {
+		errs = append(errs, s.XML.Validate(req))
+	}
+	if s.Content != nil {
+		errs = append(errs, s.Content.Validate(req))
+	}
+	return validationErr(errs)
+}
+
+// validationErr returns the section errors as a schema error, an unsupported
+// media type is returned on its own since the body could not be validated.
+func validationErr(errs []error) error {
+	for _, err := range errs {
+		var mediaTypeErr *rerror.MediaTypeErr
+		if errors.As(err, &mediaTypeErr) {
+			return mediaTypeErr
+		}
+	}
+	return rerror.SchemaFromErrors("request validation", errs...)
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := schemaref.Decode(reader, &schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case schema.Endpoint == nil && schema.Query == nil && schema.Header == nil && schema.Cookie == nil && schema.Body == nil && schema.Form == nil && schema.Multipart == nil && schema.XML == nil && schema.Content == nil:
+		return errors.New("schema requires at least one section")
+	case bodies(schema) > 1:
+		return errors.New("schema can only have one of body, form, multipart, xml or content")
+	default:
+	}
+	if schema.Endpoint != nil {
+		if err := endpoint.SchemaModelValidator(*schema.Endpoint); err != nil {
+			return fmt.Errorf("schema endpoint: %w", err)
+		}
+	}
+	if schema.Query != nil {
+		if err := query.SchemaModelValidator(*schema.Query); err != nil {
+			return fmt.Errorf("schema query: %w", err)
+		}
+	}
+	if schema.Header != nil {
+		if err := header.SchemaModelValidator(*schema.Header); err != nil {
+			return fmt.Errorf("schema header: %w", err)
+		}
+	}
+	if schema.Cookie != nil {
+		if err := cookie.SchemaModelValidator(*schema.Cookie); err != nil {
+			return fmt.Errorf("schema cookie: %w", err)
+		}
+	}
+	if schema.Body != nil {
+		if err := jbody.Lint(*schema.Body); err != nil {
+			return fmt.Errorf("schema body: %w", err)
+		}
+	}
+	if schema.Form != nil {
+		if err := form.SchemaModelValidator(*schema.Form); err != nil {
+			return fmt.Errorf("schema form: %w", err)
+		}
+	}
+	if schema.Multipart != nil {
+		if err := mbody.SchemaModelValidator(*schema.Multipart); err != nil {
+			return fmt.Errorf("schema multipart: %w", err)
+		}
+	}
+	if schema.XML != nil {
+		if err := xbody.SchemaModelValidator(*schema.XML); err != nil {
+			return fmt.Errorf("schema xml: %w", err)
+		}
+	}
+	if schema.Content != nil {
+		if err := SchemaModelContentValidator(*schema.Content); err != nil {
+			return fmt.Errorf("schema content: %w", err)
+		}
+	}
+	return nil
+}
+
+// bodies returns the number of request body sections.
+func bodies(schema Schema) int {
+	count := 0
+	if schema.Body != nil {
+		count++
+	}
+	if schema.Form != nil {
+		count++
+	}
+	if schema.Multipart != nil {
+		count++
+	}
+	if schema.XML != nil {
+		count++
+	}
+	if schema.Content != nil {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,249 @@
+package request
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testSchemaJSON = `{
+	"title": "Request Schema",
+	"description": "endpoint, query and body validation",
+	"endpoint": {
+		"method": "POST",
+		"endpoint": "/users/{id}",
+		"path_variables": {
+			"{id}": {
+				"validation": {
+					"number_validator": {
+						"min": 1
+					}
+				}
+			}
+		}
+	},
+	"query": {
+		"parameters": {
+			"verbose": {
+				"validation": {
+					"boolean_validator": {}
+				}
+			}
+		}
+	},
+	"header": {
+		"required_fields": {
+			"one_of": [["x-request-id"]]
+		},
+		"parameters": {
+			"X-Request-ID": {
+				"validation": {
+					"string_validator": {
+						"regex": "^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$"
+					}
+				}
+			}
+		}
+	},
+	"body": {
+		"body": {
+			"object": {
+				"required_fields": {
+					"one_of": [["name"]]
+				},
+				"parameters": {
+					"name": {
+						"validation": {
+							"string_validator": {}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestSchemaFromJSON(t *testing.T) {
+	type args struct {
+		reader string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				reader: testSchemaJSON,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: references",
+			args: args{
+				reader: `{
+					"definitions": {
+						"name": {"validation": {"string_validator": {"regex": "^[a-z]+$"}}}
+					},
+					"query": {"parameters": {"name": {"$ref": "#/definitions/name"}}},
+					"body": {"body": {"object": {"parameters": {"name": {"$ref": "#/definitions/name"}}}}}
+				}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: dangling reference",
+			args: args{
+				reader: `{"query": {"parameters": {"name": {"$ref": "#/definitions/name"}}}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: no sections",
+			args: args{
+				reader: `{"title": "empty"}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: endpoint section",
+			args: args{
+				reader: `{"endpoint": {"endpoint": "/users"}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: query section",
+			args: args{
+				reader: `{"query": {"title": "no parameters"}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: header section",
+			args: args{
+				reader: `{"header": {"title": "no parameters"}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: cookie section",
+			args: args{
+				reader: `{"cookie": {"title": "no parameters"}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: form section",
+			args: args{
+				reader: `{"form": {"title": "no parameters"}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: body and form",
+			args: args{
+				reader: `{
+					"body": {"body": {"object": {"parameters": {"name": {"validation": {"string_validator": {}}}}}}},
+					"form": {"parameters": {"name": {"validation": {"string_validator": {}}}}}
+				}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: multipart section",
+			args: args{
+				reader: `{"multipart": {"files": {"avatar": {"max_size": -1}}}}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: json",
+			args: args{
+				reader: `{"title": `,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromJSON(strings.NewReader(tt.args.reader))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SchemaFromJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	type args struct {
+		req *http.Request
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		wantSections int
+	}{
+		{
+			name: "success",
+			args: args{
+				req: func() *http.Request {
+					r := httptest.NewRequest(http.MethodPost, "http://www.test.com/users/10?verbose=true", strings.NewReader(`{"name": "Gary"}`))
+					r.Header.Set("X-Request-ID", "8b4a60d8-c203-460f-92eb-82646c93d792")
+					return r
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: endpoint",
+			args: args{
+				req: func() *http.Request {
+					r := httptest.NewRequest(http.MethodPost, "http://www.test.com/users/0?verbose=true", strings.NewReader(`{"name": "Gary"}`))
+					r.Header.Set("X-Request-ID", "8b4a60d8-c203-460f-92eb-82646c93d792")
+					return r
+				}(),
+			},
+			wantErr:      true,
+			wantSections: 1,
+		},
+		{
+			name: "fail: all sections",
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, "http://www.test.com/users/0?verbose=yes", strings.NewReader(`{"age": 10}`)),
+			},
+			wantErr:      true,
+			wantSections: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				return
+			}
+			var schemaErr *rerror.SchemaErr
+			if !errors.As(err, &schemaErr) {
+				t.Errorf("Schema.Validate() error = %T, want *rerror.SchemaErr", err)
+				return
+			}
+			if len(schemaErr.Sections) != tt.wantSections {
+				t.Errorf("Schema.Validate() sections = %d, want %d", len(schemaErr.Sections), tt.wantSections)
+			}
+		})
+	}
+}
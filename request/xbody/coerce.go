@@ -0,0 +1,85 @@
+package xbody
+
+import (
+	"strconv"
+
+	"github.com/g8rswimmer/httpx/request/jbody"
+)
+
+// coerce converts the XML text values to the types the object validator
+// parameters expect, numbers and booleans are parsed and a single element is
+// an array for the array validators.  Values that can not be converted are
+// left for the validators to report.
+func coerce(obj jbody.ObjectValidator, value any) any {
+	fields, ok := value.(map[string]any)
+	if !ok {
+		if s, isString := value.(string); isString && len(s) == 0 {
+			return map[string]any{}
+		}
+		return value
+	}
+	for name, v := range fields {
+		properties, has := obj.Parameters[name]
+		if !has {
+			continue
+		}
+		fields[name] = coerceValue(properties.Validation, v)
+	}
+	return fields
+}
+
+func coerceValue(validation jbody.ParameterValidation, value any) any {
+	switch {
+	case validation.Object != nil:
+		return coerce(*validation.Object, value)
+	case validation.ObjectArray != nil:
+		arr := parameterArray(value)
+		for i, v := range arr {
+			arr[i] = coerce(validation.ObjectArray.Object, v)
+		}
+		return arr
+	case validation.Number != nil:
+		return number(value)
+	case validation.Boolean != nil:
+		if s, ok := value.(string); ok {
+			if b, err := strconv.ParseBool(s); err == nil {
+				return b
+			}
+		}
+		return value
+	case validation.NumberArray != nil:
+		arr := parameterArray(value)
+		for i, v := range arr {
+			arr[i] = number(v)
+		}
+		return arr
+	case validation.StringArray != nil || validation.TimeArray != nil:
+		return parameterArray(value)
+	default:
+		return value
+	}
+}
+
+// parameterArray is the array of a parameter, an empty element has zero items.
+func parameterArray(value any) []any {
+	if s, ok := value.(string); ok && len(s) == 0 {
+		return []any{}
+	}
+	return array(value)
+}
+
+func array(value any) []any {
+	if arr, ok := value.([]any); ok {
+		return arr
+	}
+	return []any{value}
+}
+
+func number(value any) any {
+	if s, ok := value.(string); ok {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	}
+	return value
+}
@@ -0,0 +1,35 @@
+package xbody
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type CompiledSchema struct {
+	schema Schema
+	body   *jbody.CompiledSchema
+}
+
+func (s Schema) Compile() (*CompiledSchema, error) {
+	body, err := jbody.Schema{Body: s.Body}.Compile()
+	var lintErr *rerror.LintErr
+	switch {
+	case errors.As(err, &lintErr):
+		return nil, rerror.LintFromIssues("xml body schema compile", lintErr.Issues)
+	case err != nil:
+		return nil, err
+	}
+	return &CompiledSchema{
+		schema: s,
+		body:   body,
+	}, nil
+}
+
+// Validate decodes and validates the request XML body, the same as
+// Schema.Validate.
+func (c *CompiledSchema) Validate(req *http.Request) error {
+	return c.schema.validate(req, c.body.ValidateBody)
+}
@@ -0,0 +1,123 @@
+package xbody
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+// Schema validates an XML request body with the JSON body validators.  The
+// body is decoded into the same generic tree as a JSON body, the root element
+// is the object and the attributes are the @ prefixed names.  An object array
+// validates the child elements of the root element.
+type Schema struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Root        string     `json:"root"`
+	Body        jbody.Body `json:"body"`
+}
+
+// Validate decodes and validates the request XML body.  The request body is
+// replaced so it can be read again.
+func (s Schema) Validate(req *http.Request) error {
+	return s.validate(req, s.Body.Validate)
+}
+
+func (s Schema) validate(req *http.Request, validate func(body any) error) error {
+	var raw []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return rerror.SchemaFromError("request xml body validation", fmt.Errorf("schema body read: %w", err))
+		}
+		raw = b
+	}
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+
+	body, err := s.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return rerror.SchemaFromError("request xml body validation", err)
+	}
+	if err := validate(body); err != nil {
+		return rerror.SchemaFromError("request xml body validation", err)
+	}
+	return nil
+}
+
+// Decode decodes the XML document into the generic tree with the values
+// converted to the types of the schema validators.
+func (s Schema) Decode(reader io.Reader) (any, error) {
+	root, tree, err := decodeTree(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Root) > 0 && root != s.Root {
+		return nil, fmt.Errorf("root element [%s] is not [%s]", root, s.Root)
+	}
+	switch {
+	case s.Body.Object != nil:
+		return coerce(*s.Body.Object, tree), nil
+	case s.Body.ObjectArray != nil:
+		fields, ok := tree.(map[string]any)
+		if !ok {
+			return []any{}, nil
+		}
+		var items []any
+		for name, v := range fields {
+			if strings.HasPrefix(name, AttributePrefix) || name == TextKey {
+				continue
+			}
+			if items != nil {
+				return nil, fmt.Errorf("root element [%s] children must have the same name", root)
+			}
+			items = array(v)
+		}
+		for i, item := range items {
+			items[i] = coerce(s.Body.ObjectArray.Object, item)
+		}
+		if items == nil {
+			items = []any{}
+		}
+		return items, nil
+	default:
+		return tree, nil
+	}
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case schema.Body.Object == nil && schema.Body.ObjectArray == nil:
+		return errors.New("schema body requires an object or object array")
+	default:
+	}
+	return Lint(schema)
+}
+
+// Lint returns an error with all of the schema rules that contradict each
+// other, or can never be satisfied.
+func Lint(schema Schema) error {
+	var lintErr *rerror.LintErr
+	if err := jbody.Lint(jbody.Schema{Body: schema.Body}); errors.As(err, &lintErr) {
+		return rerror.LintFromIssues("xml body schema lint", lintErr.Issues)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package xbody
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testSchemaJSON = `{
+	"root": "user",
+	"body": {
+		"object": {
+			"required_fields": {"one_of": [["@id", "name"]]},
+			"parameters": {
+				"@id": {"validation": {"number_validator": {"min": 1}}},
+				"name": {"validation": {"string_validator": {"regex": "^[A-Z][a-z]+$"}}},
+				"active": {"validation": {"boolean_validator": {}}},
+				"born": {"validation": {"time_validator": {"format": "2006-01-02", "before": "2030-01-01"}}},
+				"score": {"validation": {"number_array_validator": {"max": 100}}},
+				"address": {
+					"validation": {
+						"object_validator": {
+							"parameters": {
+								"@type": {"validation": {"string_validator": {"one_of": ["home", "work"]}}},
+								"zip": {"validation": {"string_validator": {"regex": "^[0-9]{5}$"}}}
+							}
+						}
+					}
+				},
+				"pet": {
+					"validation": {
+						"object_array_validator": {
+							"object": {
+								"required_fields": {"one_of": [["name"]]},
+								"parameters": {
+									"name": {"validation": {"string_validator": {}}}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestSchema_Validate(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		t.Fatalf("Schema.Compile() error = %v", err)
+	}
+	type args struct {
+		body string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantPointers []string
+		wantErr      bool
+	}{
+		{
+			name: "success",
+			args: args{
+				body: `<user id="10">
+					<name>Gary</name>
+					<active>true</active>
+					<born>1980-05-01</born>
+					<score>90</score>
+					<address type="home"><zip>75001</zip></address>
+					<pet><name>rex</name></pet>
+				</user>`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: repeated elements",
+			args: args{
+				body: `<user id="10"><name>Gary</name><score>90</score><score>80</score><pet><name>rex</name></pet><pet><name>tom</name></pet></user>`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: empty elements",
+			args: args{
+				body: `<user id="10"><name>Gary</name><score/><pet/></user>`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: root",
+			args: args{
+				body: `<person id="10"><name>Gary</name></person>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: required",
+			args: args{
+				body: `<user><name>Gary</name></user>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: unknown",
+			args: args{
+				body: `<user id="10"><name>Gary</name><age>40</age></user>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: values",
+			args: args{
+				body: `<user id="0">
+					<name>gary</name>
+					<active>maybe</active>
+					<born>2040-01-01</born>
+					<score>90</score><score>120</score>
+					<address type="other"><zip>7500</zip></address>
+					<pet><name>rex</name></pet><pet></pet>
+				</user>`,
+			},
+			wantPointers: []string{
+				"/@id",
+				"/active",
+				"/address/@type",
+				"/address/zip",
+				"/born",
+				"/name",
+				"/pet/1",
+				"/score",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: xml",
+			args: args{
+				body: `<user id="10"><name>Gary</user>`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []interface{ Validate(*http.Request) error }{schema, compiled} {
+				req := httptest.NewRequest(http.MethodPost, "http://www.test.com", strings.NewReader(tt.args.body))
+				err := v.Validate(req)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if b, _ := io.ReadAll(req.Body); string(b) != tt.args.body {
+					t.Errorf("Schema.Validate() body = %s", string(b))
+				}
+				if tt.wantPointers == nil {
+					continue
+				}
+				var schemaErr *rerror.SchemaErr
+				if !errors.As(err, &schemaErr) || schemaErr.Parameter == nil {
+					t.Fatalf("Schema.Validate() error = %v, want a parameter error", err)
+				}
+				got := []string{}
+				for _, violation := range schemaErr.Parameter.Violations {
+					got = append(got, violation.Pointer)
+				}
+				if !reflect.DeepEqual(got, tt.wantPointers) {
+					t.Errorf("Schema.Validate() pointers = %v, want %v", got, tt.wantPointers)
+				}
+			}
+		})
+	}
+}
+
+func TestSchema_Validate_ObjectArray(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(`{
+		"root": "users",
+		"body": {
+			"object_array": {
+				"object": {
+					"parameters": {
+						"@id": {"validation": {"number_validator": {"min": 1}}},
+						"name": {"validation": {"string_validator": {}}}
+					}
+				}
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			body:    `<users count="2"><user id="1"><name>Gary</name></user><user id="2"><name>Tom</name></user></users>`,
+			wantErr: false,
+		},
+		{
+			name:    "success: single",
+			body:    `<users><user id="1"><name>Gary</name></user></users>`,
+			wantErr: false,
+		},
+		{
+			name:    "fail: item",
+			body:    `<users><user id="1"><name>Gary</name></user><user id="0"><name>Tom</name></user></users>`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: children names",
+			body:    `<users><user id="1"/><admin id="2"/></users>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "http://www.test.com", strings.NewReader(tt.body))
+			if err := schema.Validate(req); (err != nil) != tt.wantErr {
+				t.Errorf("Schema.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchema_Decode_Empty(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	got, err := schema.Decode(strings.NewReader(`<user id="10"><name>Gary</name><score/><pet/></user>`))
+	if err != nil {
+		t.Fatalf("Schema.Decode() error = %v", err)
+	}
+	want := map[string]any{
+		"@id":   10.0,
+		"name":  "Gary",
+		"score": []any{},
+		"pet":   []any{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Schema.Decode() = %v, want %v", got, want)
+	}
+}
+
+func TestSchemaFromJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			schema:  testSchemaJSON,
+			wantErr: false,
+		},
+		{
+			name:    "fail: body",
+			schema:  `{"root": "user"}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail: lint",
+			schema:  `{"body": {"object": {"parameters": {"@id": {"validation": {"number_validator": {"min": 10, "max": 1}}}}}}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SchemaFromJSON(strings.NewReader(tt.schema)); (err != nil) != tt.wantErr {
+				t.Errorf("SchemaFromJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package xbody
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// AttributePrefix is the prefix of the attribute names in the tree.
+	AttributePrefix = "@"
+	// TextKey is the name of the element text when the element also has
+	// attributes or child elements.
+	TextKey = "#text"
+)
+
+// element is an XML element being decoded.
+type element struct {
+	name     string
+	fields   map[string]any
+	text     strings.Builder
+	children bool
+}
+
+// decodeTree decodes the XML document into the generic tree.  An element with
+// only text is a string, otherwise it is an object with the attributes, the
+// child elements and the text.  Repeated child elements are an array.  The
+// names are the local names, the namespaces are ignored.
+func decodeTree(reader io.Reader) (string, any, error) {
+	dec := xml.NewDecoder(reader)
+	var stack []*element
+	for {
+		tok, err := dec.Token()
+		switch {
+		case errors.Is(err, io.EOF):
+			return "", nil, errors.New("schema body xml decode: root element not present")
+		case err != nil:
+			return "", nil, fmt.Errorf("schema body xml decode: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{
+				name:   t.Name.Local,
+				fields: map[string]any{},
+			}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				e.fields[AttributePrefix+attr.Name.Local] = attr.Value
+			}
+			if len(stack) > 0 {
+				stack[len(stack)-1].children = true
+			}
+			stack = append(stack, e)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return e.name, e.value(), nil
+			}
+			stack[len(stack)-1].add(e.name, e.value())
+		}
+	}
+}
+
+func (e *element) value() any {
+	text := strings.TrimSpace(e.text.String())
+	if len(e.fields) == 0 && !e.children {
+		return text
+	}
+	if len(text) > 0 {
+		e.fields[TextKey] = text
+	}
+	return e.fields
+}
+
+func (e *element) add(name string, value any) {
+	existing, has := e.fields[name]
+	switch {
+	case !has:
+		e.fields[name] = value
+	default:
+		if arr, ok := existing.([]any); ok {
+			e.fields[name] = append(arr, value)
+			return
+		}
+		e.fields[name] = []any{existing, value}
+	}
+}
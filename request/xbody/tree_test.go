@@ -0,0 +1,88 @@
+package xbody
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_decodeTree(t *testing.T) {
+	type args struct {
+		body string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantRoot string
+		want     any
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			args: args{
+				body: `<?xml version="1.0"?>
+				<user xmlns="http://www.test.com/user" id="10">
+					<name>Gary</name>
+					<pet>rex</pet>
+					<pet>tom</pet>
+					<address type="home"><zip>75001</zip></address>
+					<note lang="en">hello</note>
+					<empty/>
+				</user>`,
+			},
+			wantRoot: "user",
+			want: map[string]any{
+				"@id":  "10",
+				"name": "Gary",
+				"pet":  []any{"rex", "tom"},
+				"address": map[string]any{
+					"@type": "home",
+					"zip":   "75001",
+				},
+				"note": map[string]any{
+					"@lang": "en",
+					"#text": "hello",
+				},
+				"empty": "",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: text root",
+			args: args{
+				body: `<name>Gary</name>`,
+			},
+			wantRoot: "name",
+			want:     "Gary",
+			wantErr:  false,
+		},
+		{
+			name: "fail: syntax",
+			args: args{
+				body: `<user><name>Gary</user>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: empty",
+			args: args{
+				body: ``,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, got, err := decodeTree(strings.NewReader(tt.args.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeTree() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if root != tt.wantRoot {
+				t.Errorf("decodeTree() root = %s, want %s", root, tt.wantRoot)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeTree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
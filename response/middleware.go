@@ -0,0 +1,121 @@
+package response
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type Validator interface {
+	Validate(resp *http.Response) error
+}
+
+type ErrorHandler func(req *http.Request, err error)
+
+// Validation records the handler response and validates it before it is
+// written to the client, the response is always written.  The response is
+// buffered so the middleware is meant for tests and staging.  MaxBytes bounds
+// the buffered body, zero does not bound it.  A response that is larger than
+// MaxBytes, or that the handler flushes, is written as it is and not
+// validated.
+type Validation struct {
+	Validator    Validator
+	ErrorHandler ErrorHandler
+	MaxBytes     int64
+}
+
+func (v Validation) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rec := &recorder{
+			w:      w,
+			header: http.Header{},
+			code:   http.StatusOK,
+			max:    v.MaxBytes,
+		}
+		next.ServeHTTP(rec, req)
+		if rec.committed {
+			return
+		}
+
+		if err := v.Validator.Validate(rec.result(req)); err != nil && v.ErrorHandler != nil {
+			v.ErrorHandler(req, err)
+		}
+		rec.commit()
+	})
+}
+
+// recorder buffers the response until it is validated, or until the body is
+// greater than max or flushed, when it is committed to the writer.
+type recorder struct {
+	w           http.ResponseWriter
+	header      http.Header
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+	max         int64
+	committed   bool
+}
+
+func (r *recorder) Header() http.Header {
+	if r.committed {
+		return r.w.Header()
+	}
+	return r.header
+}
+
+func (r *recorder) WriteHeader(code int) {
+	switch {
+	case r.committed:
+		r.w.WriteHeader(code)
+	case !r.wroteHeader:
+		r.code = code
+		r.wroteHeader = true
+	}
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	if !r.committed && r.max > 0 && int64(r.body.Len()+len(b)) > r.max {
+		r.commit()
+	}
+	if r.committed {
+		return r.w.Write(b)
+	}
+	return r.body.Write(b)
+}
+
+func (r *recorder) Flush() {
+	r.commit()
+	if f, ok := r.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *recorder) commit() {
+	if r.committed {
+		return
+	}
+	r.committed = true
+	for key, values := range r.header {
+		r.w.Header()[key] = values
+	}
+	r.w.WriteHeader(r.code)
+	r.w.Write(r.body.Bytes())
+}
+
+func (r *recorder) result(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%03d %s", r.code, http.StatusText(r.code)),
+		StatusCode:    r.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body.Bytes())),
+		ContentLength: int64(r.body.Len()),
+		Request:       req,
+	}
+}
@@ -0,0 +1,101 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidation_Handler(t *testing.T) {
+	type args struct {
+		statusCode int
+		maxBytes   int64
+		flush      bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				statusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: status code",
+			args: args{
+				statusCode: http.StatusInternalServerError,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: status code max bytes",
+			args: args{
+				statusCode: http.StatusInternalServerError,
+				maxBytes:   4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "success: greater than max bytes",
+			args: args{
+				statusCode: http.StatusInternalServerError,
+				maxBytes:   2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: flushed",
+			args: args{
+				statusCode: http.StatusInternalServerError,
+				flush:      true,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr error
+			v := Validation{
+				Validator: Schema{
+					StatusCodes: []int{http.StatusOK},
+				},
+				ErrorHandler: func(req *http.Request, err error) {
+					validationErr = err
+				},
+				MaxBytes: tt.args.maxBytes,
+			}
+			next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("X-Test", "test")
+				w.WriteHeader(tt.args.statusCode)
+				w.Write([]byte("bo"))
+				if tt.args.flush {
+					w.(http.Flusher).Flush()
+				}
+				w.Write([]byte("dy"))
+			})
+
+			rec := httptest.NewRecorder()
+			v.Handler(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://www.test.com", nil))
+
+			if (validationErr != nil) != tt.wantErr {
+				t.Errorf("Validation.Handler() error = %v, wantErr %v", validationErr, tt.wantErr)
+			}
+			if rec.Code != tt.args.statusCode {
+				t.Errorf("Validation.Handler() status code = %d, want %d", rec.Code, tt.args.statusCode)
+			}
+			if rec.Header().Get("X-Test") != "test" {
+				t.Errorf("Validation.Handler() header not written")
+			}
+			if rec.Flushed != tt.args.flush {
+				t.Errorf("Validation.Handler() flushed = %v, want %v", rec.Flushed, tt.args.flush)
+			}
+			if rec.Body.String() != "body" {
+				t.Errorf("Validation.Handler() body = %s, want body", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/g8rswimmer/httpx/request/header"
+	"github.com/g8rswimmer/httpx/request/jbody"
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+type Schema struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	StatusCodes []int          `json:"status_codes"`
+	Header      *header.Schema `json:"header"`
+	Body        *jbody.Body    `json:"body"`
+}
+
+func (s Schema) Validate(resp *http.Response) error {
+	errs := []error{
+		s.validateStatusCode(resp.StatusCode),
+	}
+	if s.Header != nil {
+		errs = append(errs, rerror.SchemaFromError("response header validation", s.Header.ValidateHeader(resp.Header)))
+	}
+	if s.Body != nil {
+		errs = append(errs, s.validateBody(resp))
+	}
+	return rerror.SchemaFromErrors("response validation", errs...)
+}
+
+func (s Schema) ValidateRecorder(rec *httptest.ResponseRecorder) error {
+	return s.Validate(rec.Result())
+}
+
+func (s Schema) validateStatusCode(statusCode int) error {
+	if len(s.StatusCodes) == 0 {
+		return nil
+	}
+	for _, code := range s.StatusCodes {
+		if code == statusCode {
+			return nil
+		}
+	}
+	return rerror.SchemaFromError("response status validation", fmt.Errorf("response status code [%d] not in %v", statusCode, s.StatusCodes))
+}
+
+// validateBody decodes the response body and replaces it so the caller is
+// still able to read it.
+func (s Schema) validateBody(resp *http.Response) error {
+	if resp.Body == nil {
+		return rerror.SchemaFromError("response json body validation", errors.New("response body is not present"))
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return rerror.SchemaFromError("response json body validation", fmt.Errorf("response body read: %w", err))
+	}
+	var body any
+	if err := json.Unmarshal(b, &body); err != nil {
+		return rerror.SchemaFromError("response json body validation", fmt.Errorf("schema body json decode: %w", err))
+	}
+	if err := s.Body.Validate(body); err != nil {
+		return rerror.SchemaFromError("response json body validation", err)
+	}
+	return nil
+}
+
+func SchemaFromJSON(reader io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(reader).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode json: %w", err)
+	}
+	if err := SchemaModelValidator(schema); err != nil {
+		return Schema{}, fmt.Errorf("schema decode validation: %w", err)
+	}
+	return schema, nil
+}
+
+func SchemaModelValidator(schema Schema) error {
+	switch {
+	case len(schema.StatusCodes) == 0 && schema.Header == nil && schema.Body == nil:
+		return errors.New("schema requires status codes, header or body")
+	default:
+	}
+	for _, code := range schema.StatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("schema status code [%d] is not valid", code)
+		}
+	}
+	if schema.Header != nil {
+		if err := header.SchemaModelValidator(*schema.Header); err != nil {
+			return fmt.Errorf("schema header: %w", err)
+		}
+	}
+	if schema.Body != nil {
+		if err := jbody.Lint(jbody.Schema{Body: *schema.Body}); err != nil {
+			return fmt.Errorf("schema body: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package response
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/g8rswimmer/httpx/request/rerror"
+)
+
+const testSchemaJSON = `{
+	"title": "Response Schema",
+	"description": "status code, header and body validation",
+	"status_codes": [200, 201],
+	"header": {
+		"required_fields": {
+			"one_of": [["content-type"]]
+		},
+		"parameters": {
+			"Content-Type": {
+				"validation": {
+					"string_validator": {
+						"value": "application/json"
+					}
+				}
+			}
+		}
+	},
+	"body": {
+		"object": {
+			"required_fields": {
+				"one_of": [["id", "name"]]
+			},
+			"parameters": {
+				"id": {
+					"validation": {
+						"number_validator": {
+							"min": 1
+						}
+					}
+				},
+				"name": {
+					"validation": {
+						"string_validator": {}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestSchemaFromJSON(t *testing.T) {
+	type args struct {
+		reader string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				reader: testSchemaJSON,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: empty",
+			args: args{
+				reader: `{"title": "empty"}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: status code",
+			args: args{
+				reader: `{"status_codes": [42]}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: header",
+			args: args{
+				reader: `{"header": {}}`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromJSON(strings.NewReader(tt.args.reader))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SchemaFromJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchema_ValidateRecorder(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	type args struct {
+		statusCode  int
+		contentType string
+		body        string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		wantSections int
+	}{
+		{
+			name: "success",
+			args: args{
+				statusCode:  http.StatusCreated,
+				contentType: "application/json",
+				body:        `{"id": 10, "name": "Gary"}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail: status code",
+			args: args{
+				statusCode:  http.StatusAccepted,
+				contentType: "application/json",
+				body:        `{"id": 10, "name": "Gary"}`,
+			},
+			wantErr:      true,
+			wantSections: 1,
+		},
+		{
+			name: "fail: all",
+			args: args{
+				statusCode:  http.StatusInternalServerError,
+				contentType: "text/plain",
+				body:        `internal error`,
+			},
+			wantErr:      true,
+			wantSections: 3,
+		},
+		{
+			name: "fail: body",
+			args: args{
+				statusCode:  http.StatusOK,
+				contentType: "application/json",
+				body:        `{"id": 0, "name": "Gary"}`,
+			},
+			wantErr:      true,
+			wantSections: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			rec.Header().Set("Content-Type", tt.args.contentType)
+			rec.WriteHeader(tt.args.statusCode)
+			rec.WriteString(tt.args.body)
+
+			err := schema.ValidateRecorder(rec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Schema.ValidateRecorder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				return
+			}
+			var schemaErr *rerror.SchemaErr
+			if !errors.As(err, &schemaErr) {
+				t.Errorf("Schema.ValidateRecorder() error = %T, want *rerror.SchemaErr", err)
+				return
+			}
+			if len(schemaErr.Sections) != tt.wantSections {
+				t.Errorf("Schema.ValidateRecorder() sections = %d, want %d", len(schemaErr.Sections), tt.wantSections)
+			}
+		})
+	}
+}
+
+func TestSchema_Validate_Body(t *testing.T) {
+	schema, err := SchemaFromJSON(strings.NewReader(testSchemaJSON))
+	if err != nil {
+		t.Fatalf("SchemaFromJSON() error = %v", err)
+	}
+	body := `{"id": 10, "name": "Gary"}`
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+	if err := schema.Validate(resp); err != nil {
+		t.Fatalf("Schema.Validate() error = %v", err)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("response body read error = %v", err)
+	}
+	if string(b) != body {
+		t.Errorf("Schema.Validate() body = %s, want %s", string(b), body)
+	}
+}